@@ -0,0 +1,156 @@
+// Either monad represents a value of one of two possible types: a `Left` value
+// or a `Right` value. By convention `Left` holds a failure and `Right` holds a
+// success, "right" being the correct value.
+//
+// Either monad is a generalization of Result monad. Where Result monad is
+// bound to `error` as its failure type, Either monad allows the failure to be
+// of any type, such as a validation enumeration or a domain specific problem
+// structure, which does not need to implement the `error` interface.
+//
+// Like Result monad, Either monad allows to chain together a series of
+// operations where the `Left` value is propagated through the computations
+// and subsequent operations are bypassed.
+package either
+
+import (
+	"github.com/erikjuhani/go-fp/maybe"
+	"github.com/erikjuhani/go-fp/result"
+)
+
+// Either monad data type representation. Contains either value `l` or value
+// `r`
+type Either[L, R any] struct {
+	left  L
+	right R
+	ok    bool
+}
+
+// Left is the return operation for Either monad that returns the
+// representation of the left side value, which is commonly a failure
+func Left[R, L any](val L) Either[L, R] {
+	return Either[L, R]{left: val}
+}
+
+// Right is the return operation for Either monad that returns the
+// representation of the right side value, which is commonly a success
+func Right[L, R any](val R) Either[L, R] {
+	return Either[L, R]{right: val, ok: true}
+}
+
+// IsLeft is a helper function for Either monad and returns true if the Either
+// monad contains a left value
+func IsLeft[L, R any](m Either[L, R]) bool {
+	return !m.ok
+}
+
+// IsRight is a helper function for Either monad and returns true if the
+// Either monad contains a right value
+func IsRight[L, R any](m Either[L, R]) bool {
+	return m.ok
+}
+
+// Map function takes the right value of the Either monad and passes it to
+// function `f` as a parameter. The function `f` returns a new Either monad as
+// the result. Left value is propagated as is
+func Map[L, R, B any](f func(R) B) func(Either[L, R]) Either[L, B] {
+	return func(m Either[L, R]) Either[L, B] {
+		if IsLeft(m) {
+			return Left[B](m.left)
+		}
+		return Right[L](f(m.right))
+	}
+}
+
+// MapLeft function takes the left value of the Either monad and passes it to
+// function `f` as a parameter. The function `f` returns a new Either monad as
+// the result. Right value is propagated as is
+func MapLeft[R, L, B any](f func(L) B) func(Either[L, R]) Either[B, R] {
+	return func(m Either[L, R]) Either[B, R] {
+		if IsLeft(m) {
+			return Left[R](f(m.left))
+		}
+		return Right[B](m.right)
+	}
+}
+
+// Bimap function maps both sides of the Either monad. Function `fl` is applied
+// to the left value and function `fr` is applied to the right value
+func Bimap[L, R, A, B any](fl func(L) A, fr func(R) B) func(Either[L, R]) Either[A, B] {
+	return func(m Either[L, R]) Either[A, B] {
+		if IsLeft(m) {
+			return Left[B](fl(m.left))
+		}
+		return Right[A](fr(m.right))
+	}
+}
+
+// Fmap or also known as `bind` function lets non-monadic function `f` to
+// operate on the contents of monad m a, and lifts the value to a new domain
+// (Either l a -> Either l b)
+func Fmap[L, R, B any](f func(R) Either[L, B]) func(Either[L, R]) Either[L, B] {
+	return func(m Either[L, R]) Either[L, B] {
+		if IsLeft(m) {
+			return Left[B](m.left)
+		}
+		return f(m.right)
+	}
+}
+
+// Match matches Either monad depending of it's current state and returns the
+// value determined by the return type of b
+func Match[L, R, B any](Left func(L) B, Right func(R) B) func(Either[L, R]) B {
+	return func(m Either[L, R]) B {
+		if IsLeft(m) {
+			return Left(m.left)
+		}
+		return Right(m.right)
+	}
+}
+
+// Swap swaps the sides of the Either monad turning a left value into a right
+// value and vice versa
+func Swap[L, R any](m Either[L, R]) Either[R, L] {
+	if IsLeft(m) {
+		return Right[R](m.left)
+	}
+	return Left[L](m.right)
+}
+
+// FromResult converts Result monad into Either monad. Err is converted into a
+// left value and Ok into a right value
+func FromResult[A any](m result.Result[A]) Either[error, A] {
+	return result.Match(Left[A, error], Right[error, A])(m)
+}
+
+// ToResult converts Either monad with an `error` on the left side into Result
+// monad. Left value is converted into Err and right value into Ok. A `nil`
+// left value is converted into Err with result.ErrNil
+func ToResult[A any](m Either[error, A]) result.Result[A] {
+	return Match(
+		func(err error) result.Result[A] {
+			if err == nil {
+				err = result.ErrNil
+			}
+			return result.Err[A](err)
+		},
+		result.Ok[A],
+	)(m)
+}
+
+// FromMaybe converts Maybe monad into Either monad. Just is converted into a
+// right value and Nothing into the given left value `l`
+func FromMaybe[R, L any](l L) func(maybe.Maybe[R]) Either[L, R] {
+	return maybe.Match(
+		func() Either[L, R] { return Left[R](l) },
+		Right[L, R],
+	)
+}
+
+// ToMaybe converts Either monad into Maybe monad. Right value is converted
+// into Just and left value is discarded and converted into Nothing
+func ToMaybe[L, R any](m Either[L, R]) maybe.Maybe[R] {
+	return Match(
+		func(L) maybe.Maybe[R] { return maybe.Nothing[R]() },
+		maybe.Just[R],
+	)(m)
+}
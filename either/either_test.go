@@ -0,0 +1,226 @@
+package either
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/maybe"
+	"github.com/erikjuhani/go-fp/pipe"
+	"github.com/erikjuhani/go-fp/result"
+)
+
+type problem struct {
+	status int
+	title  string
+}
+
+func head[T any](slice []T) Either[problem, T] {
+	if len(slice) > 0 {
+		return Right[problem](slice[0])
+	}
+	return Left[T](problem{404, "not found"})
+}
+
+func inverse(x int) Either[problem, float32] {
+	if x == 0 {
+		return Left[float32](problem{400, "division by zero"})
+	}
+
+	return Right[problem](1 / float32(x))
+}
+
+func show[R any](m Either[problem, R]) string {
+	return Match(
+		func(p problem) string { return fmt.Sprintf("%d %s", p.status, p.title) },
+		func(val R) string { return fmt.Sprint(val) },
+	)(m)
+}
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []string
+	}{
+		{"404 not found", []string{}},
+		{"HELLO", []string{"hello", "world"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				head[string],
+				Map[problem](strings.ToUpper),
+			)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestMapLeft(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []string
+	}{
+		{"not found", []string{}},
+		{"hello", []string{"hello", "world"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe3(
+				head[string],
+				MapLeft[string](func(p problem) string { return p.title }),
+				Match(
+					func(l string) string { return l },
+					func(r string) string { return r },
+				),
+			)(tt.data)
+
+			if result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestBimap(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []int
+	}{
+		{"404", []int{}},
+		{"2", []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe3(
+				head[int],
+				Bimap(
+					func(p problem) int { return p.status },
+					func(x int) int { return x * 2 },
+				),
+				Match(
+					func(l int) string { return fmt.Sprint(l) },
+					func(r int) string { return fmt.Sprint(r) },
+				),
+			)(tt.data)
+
+			if result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFmap(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []int
+	}{
+		{"404 not found", []int{}},
+		{"400 division by zero", []int{0}},
+		{"0.2", []int{5, 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				head[int],
+				Fmap(inverse),
+			)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestSwap(t *testing.T) {
+	tests := []struct {
+		expected bool
+		data     Either[string, int]
+	}{
+		{true, Left[int]("left")},
+		{false, Right[string](1)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := IsRight(Swap(tt.data)); result != tt.expected {
+				t.Errorf("expected %t, but got %t", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestResultConversion(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     result.Result[string]
+	}{
+		{"failure", result.Err[string](errors.New("failure"))},
+		{"success", result.Ok("success")},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			res := pipe.Pipe3(
+				FromResult[string],
+				ToResult[string],
+				result.Match(
+					func(err error) string { return err.Error() },
+					func(val string) string { return val },
+				),
+			)(tt.data)
+
+			if res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestToResultNil(t *testing.T) {
+	res := result.Match(
+		func(err error) error { return err },
+		func(string) error { return nil },
+	)(ToResult(Left[string, error](nil)))
+
+	if !errors.Is(res, result.ErrNil) {
+		t.Errorf("expected %v, but got %v", result.ErrNil, res)
+	}
+}
+
+func TestMaybeConversion(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     maybe.Maybe[string]
+	}{
+		{"Nothing", maybe.Nothing[string]()},
+		{"Just", maybe.Just("Just")},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe3(
+				FromMaybe[string]("left"),
+				ToMaybe[string, string],
+				maybe.Match(
+					func() string { return "Nothing" },
+					func(val string) string { return val },
+				),
+			)(tt.data)
+
+			if result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package maybe
+
+// Sequence turns a slice of Maybe monads into a Maybe monad of a slice. The
+// result is Just only if all Maybe monads are Just, otherwise Nothing
+func Sequence[A any](ms []Maybe[A]) Maybe[[]A] {
+	vals := make([]A, 0, len(ms))
+	for _, m := range ms {
+		if !m.ok {
+			return Nothing[[]A]()
+		}
+		vals = append(vals, m.val)
+	}
+	return Just(vals)
+}
+
+// Traverse applies function `f` to each element of the slice and returns the
+// results as a Maybe monad of a slice. The traversal is stopped on the first
+// Nothing, which is returned
+func Traverse[A, B any](f func(A) Maybe[B]) func([]A) Maybe[[]B] {
+	return func(as []A) Maybe[[]B] {
+		vals := make([]B, 0, len(as))
+		for _, a := range as {
+			m := f(a)
+			if !m.ok {
+				return Nothing[[]B]()
+			}
+			vals = append(vals, m.val)
+		}
+		return Just(vals)
+	}
+}
+
+// CatMaybes discards the Nothing values from a slice of Maybe monads and
+// returns the Just values
+func CatMaybes[A any](ms []Maybe[A]) []A {
+	vals := make([]A, 0, len(ms))
+	for _, m := range ms {
+		if m.ok {
+			vals = append(vals, m.val)
+		}
+	}
+	return vals
+}
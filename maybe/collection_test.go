@@ -0,0 +1,66 @@
+package maybe
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestSequence(t *testing.T) {
+	tests := []struct {
+		expected any
+		data     []Maybe[int]
+	}{
+		{"[]", nil},
+		{"[1 2]", []Maybe[int]{Just(1), Just(2)}},
+		{"Nothing", []Maybe[int]{Just(1), Nothing[int]()}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Map(func(v []int) string { return fmt.Sprint(v) })(Sequence(tt.data))
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	tests := []struct {
+		expected any
+		data     []int
+	}{
+		{"[]", []int{}},
+		{"[1 0.5]", []int{1, 2}},
+		{"Nothing", []int{1, 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Map(func(v []float32) string { return fmt.Sprint(v) })(Traverse(inverse)(tt.data))
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestCatMaybes(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []Maybe[int]
+	}{
+		{"[]", nil},
+		{"[1 2]", []Maybe[int]{Just(1), Nothing[int](), Just(2)}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := fmt.Sprint(CatMaybes(tt.data)); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package maybe
+
+// Ordered is a constraint that permits any type that supports the operators
+// `<`, `<=`, `>=` and `>`
+type Ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+// Equal reports whether the Maybe monads `a` and `b` are equal. Two Nothing
+// values are equal, and two Just values are equal if their values are equal
+func Equal[A comparable](a, b Maybe[A]) bool {
+	return a == b
+}
+
+// EqualFunc reports whether the Maybe monads `a` and `b` are equal using the
+// function `eq` to compare the values. Two Nothing values are equal and the
+// function `eq` is called only if both are Just
+func EqualFunc[A, B any](a Maybe[A], b Maybe[B], eq func(A, B) bool) bool {
+	if !a.ok || !b.ok {
+		return a.ok == b.ok
+	}
+	return eq(a.val, b.val)
+}
+
+// Compare returns an integer comparing the Maybe monads `a` and `b`. The
+// result is -1 if a < b, 0 if a == b and +1 if a > b. Nothing is ordered
+// before any Just value, and Just values are ordered by their values. A NaN
+// value is ordered before any other floating-point value
+func Compare[A Ordered](a, b Maybe[A]) int {
+	switch {
+	case !a.ok && !b.ok:
+		return 0
+	case !a.ok:
+		return -1
+	case !b.ok:
+		return 1
+	}
+
+	x, y := a.val, b.val
+	xNaN, yNaN := x != x, y != y
+	switch {
+	case xNaN && yNaN:
+		return 0
+	case xNaN || x < y:
+		return -1
+	case yNaN || x > y:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,121 @@
+package maybe
+
+import (
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		expected bool
+		a        Maybe[int]
+		b        Maybe[int]
+	}{
+		{true, Nothing[int](), Nothing[int]()},
+		{true, Just(1), Just(1)},
+		{true, Just(0), From(0)},
+		{false, Just(1), Just(2)},
+		{false, Just(0), Nothing[int]()},
+		{false, Nothing[int](), Just(0)},
+		{true, Nothing[int](), From(1, false)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := Equal(tt.a, tt.b); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+			if result := tt.a == tt.b; result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	eq := func(a []int, b string) bool { return strconv.Itoa(len(a)) == b }
+
+	tests := []struct {
+		expected bool
+		a        Maybe[[]int]
+		b        Maybe[string]
+	}{
+		{true, Nothing[[]int](), Nothing[string]()},
+		{true, Just([]int{1, 2}), Just("2")},
+		{false, Just([]int{1}), Just("2")},
+		{false, Just([]int{}), Nothing[string]()},
+		{false, Nothing[[]int](), Just("0")},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := EqualFunc(tt.a, tt.b, eq); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestCompare(t *testing.T) {
+	nan := math.NaN()
+
+	tests := []struct {
+		expected int
+		a        Maybe[float64]
+		b        Maybe[float64]
+	}{
+		{0, Nothing[float64](), Nothing[float64]()},
+		{-1, Nothing[float64](), Just(0.0)},
+		{1, Just(0.0), Nothing[float64]()},
+		{0, Just(1.0), Just(1.0)},
+		{-1, Just(1.0), Just(2.0)},
+		{1, Just(2.0), Just(1.0)},
+		{0, Just(nan), Just(nan)},
+		{-1, Just(nan), Just(0.0)},
+		{1, Just(0.0), Just(nan)},
+		{-1, Nothing[float64](), Just(nan)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := Compare(tt.a, tt.b); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMapKey(t *testing.T) {
+	counts := map[Maybe[string]]int{}
+	for _, m := range []Maybe[string]{Just("a"), Nothing[string](), Just("a"), From("", false)} {
+		counts[m]++
+	}
+
+	if result := counts[Just("a")]; result != 2 {
+		t.Errorf("expected %v, but got %v", 2, result)
+	}
+	if result := counts[Nothing[string]()]; result != 2 {
+		t.Errorf("expected %v, but got %v", 2, result)
+	}
+}
+
+func TestAllocs(t *testing.T) {
+	var (
+		double = Map(func(x int) int { return x * 2 })
+		inv    = Fmap(inverse)
+		show   = Match(
+			func() float32 { return 0 },
+			func(v float32) float32 { return v },
+		)
+	)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		sinkFloat = inv(double(Just(1)))
+		_ = show(sinkFloat)
+	})
+
+	if allocs != 0 {
+		t.Errorf("expected %v, but got %v", 0, allocs)
+	}
+}
@@ -0,0 +1,73 @@
+package maybe
+
+import "reflect"
+
+// Option determines which values are treated as absent by FromWith
+type Option uint8
+
+const (
+	// Nilable treats a `nil` pointer, map, slice, function, channel and
+	// interface as Nothing. Nilable is the option used by From.
+	Nilable Option = iota
+	// Ptr treats only a `nil` pointer and a `nil` interface as Nothing. A `nil`
+	// map, slice, function or channel is a valid value of its type and is Just.
+	Ptr
+	// Zero treats the zero value of the type as Nothing, for example 0, "",
+	// false, an empty struct and every `nil` value.
+	Zero
+)
+
+// FromWith returns a From function that uses the option `opt` to decide which
+// values are treated as absent. The returned function returns Nothing if `ok`
+// is false, or if the value is absent according to the option.
+//
+//	fromZero := maybe.FromWith[int](maybe.Zero)
+//	fromZero(0) // -> Nothing
+//	fromZero(1) // -> Just 1
+func FromWith[A any](opt Option) func(val A, ok ...bool) Maybe[A] {
+	return func(val A, ok ...bool) Maybe[A] {
+		return from(opt, val, ok...)
+	}
+}
+
+// internal
+func from[A any](opt Option, val A, ok ...bool) Maybe[A] {
+	if (len(ok) > 0 && !ok[0]) || absent(opt, val) {
+		return Nothing[A]()
+	}
+
+	return Just(val)
+}
+
+func absent[A any](opt Option, val A) bool {
+	if any(val) == nil {
+		return true
+	}
+
+	// The common types that cannot be `nil` are classified without reflection.
+	// The zero value of an interface type is `nil`, so the type parameter must
+	// be the concrete type for the zero value comparison
+	var zero A
+	if any(zero) != nil {
+		switch any(val).(type) {
+		case bool, string, int, int8, int16, int32, int64,
+			uint, uint8, uint16, uint32, uint64, uintptr, float32, float64:
+			return opt == Zero && any(val) == any(zero)
+		}
+	}
+
+	rv := reflect.ValueOf(val)
+	switch opt {
+	case Ptr:
+		return rv.Kind() == reflect.Pointer && rv.IsNil()
+	case Zero:
+		return rv.IsZero()
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Func, reflect.Chan,
+		reflect.UnsafePointer:
+		return rv.IsNil()
+	}
+	return false
+}
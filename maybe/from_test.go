@@ -0,0 +1,107 @@
+package maybe
+
+import (
+	"testing"
+)
+
+func present[A any](val A) func(Option) bool {
+	return func(opt Option) bool {
+		return Match(
+			func() bool { return false },
+			func(A) bool { return true },
+		)(FromWith[A](opt)(val))
+	}
+}
+
+func TestFromWith(t *testing.T) {
+	var (
+		x   = 1
+		np  *int
+		err error
+		s   []int
+		m   map[string]int
+		f   func()
+		c   chan int
+	)
+
+	tests := []struct {
+		name    string
+		present func(Option) bool
+		nilable bool
+		ptr     bool
+		zero    bool
+	}{
+		{"nil pointer", present(np), false, false, false},
+		{"pointer", present(&x), true, true, true},
+		{"pointer to nil pointer", present(&np), true, true, true},
+		{"nil interface", present(err), false, false, false},
+		{"nil slice", present(s), false, true, false},
+		{"empty slice", present([]int{}), true, true, true},
+		{"nil map", present(m), false, true, false},
+		{"empty map", present(map[string]int{}), true, true, true},
+		{"nil func", present(f), false, true, false},
+		{"func", present(func() {}), true, true, true},
+		{"nil chan", present(c), false, true, false},
+		{"chan", present(make(chan int)), true, true, true},
+		{"zero int", present(0), true, true, false},
+		{"int", present(1), true, true, true},
+		{"empty string", present(""), true, true, false},
+		{"string", present("a"), true, true, true},
+		{"zero struct", present(struct{ A int }{}), true, true, false},
+		{"struct", present(struct{ A int }{1}), true, true, true},
+		{"interface holding nil slice", present[any](s), false, true, false},
+		{"interface holding zero int", present[any](0), true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, c := range []struct {
+				opt      Option
+				expected bool
+			}{
+				{Nilable, tt.nilable},
+				{Ptr, tt.ptr},
+				{Zero, tt.zero},
+			} {
+				if result := tt.present(c.opt); result != c.expected {
+					t.Errorf("option %d: expected %v, but got %v", c.opt, c.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestFromWithOk(t *testing.T) {
+	for _, opt := range []Option{Nilable, Ptr, Zero} {
+		if result := show(FromWith[int](opt)(1, false)); result != "Nothing" {
+			t.Errorf("option %d: expected %v, but got %v", opt, "Nothing", result)
+		}
+	}
+}
+
+func TestFromNilable(t *testing.T) {
+	var s []int
+	if result := show(From(s)); result != "Nothing" {
+		t.Errorf("expected %v, but got %v", "Nothing", result)
+	}
+
+	if result := show(From([]int{})); result == "Nothing" {
+		t.Errorf("expected %v, but got %v", []int{}, result)
+	}
+}
+
+func BenchmarkFrom(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInt = From(i)
+	}
+}
+
+func BenchmarkFromZero(b *testing.B) {
+	fromZero := FromWith[int](Zero)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInt = fromZero(i)
+	}
+}
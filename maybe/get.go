@@ -0,0 +1,97 @@
+package maybe
+
+// IsJust is a helper function for Maybe monad and returns true if the Maybe
+// monad contains a value
+func IsJust[A any](m Maybe[A]) bool {
+	return m.ok
+}
+
+// IsNothing is a helper function for Maybe monad and returns true if the Maybe
+// monad does not contain a value
+func IsNothing[A any](m Maybe[A]) bool {
+	return !m.ok
+}
+
+// Get returns the value of the Maybe monad as a tuple `(val, ok)`, where `ok`
+// is false if the Maybe monad is Nothing. Get is the inverse of From and is
+// intended to be used when handing the value back to Go code
+func Get[A any](m Maybe[A]) (A, bool) {
+	return m.val, m.ok
+}
+
+// GetOrElse gets the value of the Maybe monad and defaults to the value `val`
+// if the Maybe monad is Nothing
+func GetOrElse[A any](val A) func(Maybe[A]) A {
+	return func(m Maybe[A]) A {
+		if m.ok {
+			return m.val
+		}
+		return val
+	}
+}
+
+// GetOrElseLazy gets the value of the Maybe monad and defaults to the value
+// returned by function `f` if the Maybe monad is Nothing. The function `f` is
+// called only when the Maybe monad is Nothing
+func GetOrElseLazy[A any](f func() A) func(Maybe[A]) A {
+	return func(m Maybe[A]) A {
+		if m.ok {
+			return m.val
+		}
+		return f()
+	}
+}
+
+// OrElse returns the Maybe monad returned by function `f` if the Maybe monad
+// is Nothing. The function `f` is called only when the Maybe monad is Nothing.
+// Just is propagated as is
+func OrElse[A any](f func() Maybe[A]) func(Maybe[A]) Maybe[A] {
+	return func(m Maybe[A]) Maybe[A] {
+		if m.ok {
+			return m
+		}
+		return f()
+	}
+}
+
+// Alt returns the alternative Maybe monad `alt` if the Maybe monad is Nothing.
+// Just is propagated as is
+func Alt[A any](alt Maybe[A]) func(Maybe[A]) Maybe[A] {
+	return func(m Maybe[A]) Maybe[A] {
+		if m.ok {
+			return m
+		}
+		return alt
+	}
+}
+
+// FirstJust returns the first Just of the given Maybe monads. If all of the
+// Maybe monads are Nothing, Nothing is returned
+func FirstJust[A any](ms ...Maybe[A]) Maybe[A] {
+	for _, m := range ms {
+		if m.ok {
+			return m
+		}
+	}
+	return Nothing[A]()
+}
+
+// ToPtr returns a pointer to a copy of the value of the Maybe monad, or a `nil`
+// pointer if the Maybe monad is Nothing
+func ToPtr[A any](m Maybe[A]) *A {
+	if m.ok {
+		v := m.val
+		return &v
+	}
+	return nil
+}
+
+// FromPtr returns Just the value pointed to by the pointer `p`, or Nothing if
+// the pointer is `nil`. The value is copied, so later changes through the
+// pointer are not reflected in the Maybe monad
+func FromPtr[A any](p *A) Maybe[A] {
+	if p == nil {
+		return Nothing[A]()
+	}
+	return Just(*p)
+}
@@ -0,0 +1,149 @@
+package maybe
+
+import (
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+func TestIsJust(t *testing.T) {
+	tests := []struct {
+		expected bool
+		data     Maybe[int]
+	}{
+		{true, Just(0)},
+		{false, Nothing[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := IsJust(tt.data); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+			if result := IsNothing(tt.data); result == tt.expected {
+				t.Errorf("expected %v, but got %v", !tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	tests := []struct {
+		expected   int
+		expectedOk bool
+		data       Maybe[int]
+	}{
+		{1, true, Just(1)},
+		{0, false, Nothing[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			val, ok := Get(tt.data)
+			if val != tt.expected || ok != tt.expectedOk {
+				t.Errorf("expected (%v, %v), but got (%v, %v)", tt.expected, tt.expectedOk, val, ok)
+			}
+		})
+	}
+}
+
+func TestGetOrElse(t *testing.T) {
+	tests := []struct {
+		expected string
+		calls    int
+		data     []string
+	}{
+		{"default", 1, nil},
+		{"hello", 0, []string{"hello"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(head[string], GetOrElse("default"))(tt.data)
+			if result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+
+			calls := 0
+			result = pipe.Pipe2(head[string], GetOrElseLazy(func() string {
+				calls++
+				return "default"
+			}))(tt.data)
+			if result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+			if calls != tt.calls {
+				t.Errorf("expected %v calls, but got %v", tt.calls, calls)
+			}
+		})
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	tests := []struct {
+		expected any
+		data     Maybe[int]
+		alt      Maybe[int]
+	}{
+		{1, Just(1), Just(2)},
+		{2, Nothing[int](), Just(2)},
+		{"Nothing", Nothing[int](), Nothing[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(OrElse(func() Maybe[int] { return tt.alt })(tt.data)); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+			if result := show(Alt(tt.alt)(tt.data)); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestFirstJust(t *testing.T) {
+	tests := []struct {
+		expected any
+		data     []Maybe[int]
+	}{
+		{"Nothing", nil},
+		{"Nothing", []Maybe[int]{Nothing[int](), Nothing[int]()}},
+		{1, []Maybe[int]{Nothing[int](), Just(1), Just(2)}},
+		{0, []Maybe[int]{Just(0), Just(1)}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(FirstJust(tt.data...)); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPtr(t *testing.T) {
+	if result := ToPtr(Nothing[int]()); result != nil {
+		t.Errorf("expected %v, but got %v", nil, result)
+	}
+
+	if result := show(FromPtr[int](nil)); result != "Nothing" {
+		t.Errorf("expected %v, but got %v", "Nothing", result)
+	}
+
+	x := 1
+	m := FromPtr(&x)
+	x = 2
+	if result := show(m); result != 1 {
+		t.Errorf("expected %v, but got %v", 1, result)
+	}
+
+	p := ToPtr(m)
+	*p = 3
+	if result := show(FromPtr(p)); result != 3 {
+		t.Errorf("expected %v, but got %v", 3, result)
+	}
+	if result := show(m); result != 1 {
+		t.Errorf("expected %v, but got %v", 1, result)
+	}
+}
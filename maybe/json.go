@@ -0,0 +1,35 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+var jsonNull = []byte("null")
+
+// MarshalJSON implements the json.Marshaler interface. Nothing is encoded as
+// `null` and Just is encoded as the contained value.
+func (m Maybe[A]) MarshalJSON() ([]byte, error) {
+	if !m.ok {
+		return jsonNull, nil
+	}
+	return json.Marshal(m.val)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. A `null` value is
+// decoded as Nothing and any other value is decoded as Just the value. Omitted
+// fields are left untouched, and thus decode as Nothing when the Maybe holds
+// its zero value.
+func (m *Maybe[A]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), jsonNull) {
+		*m = Nothing[A]()
+		return nil
+	}
+
+	var v A
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*m = Just(v)
+	return nil
+}
@@ -0,0 +1,73 @@
+package maybe
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type person struct {
+	Name Maybe[string] `json:"name"`
+	Age  Maybe[int]    `json:"age"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     person
+	}{
+		{`{"name":null,"age":null}`, person{}},
+		{`{"name":"John","age":null}`, person{Name: Just("John")}},
+		{`{"name":"John","age":42}`, person{Name: Just("John"), Age: Just(42)}},
+		{`{"name":"","age":0}`, person{Name: Just(""), Age: Just(0)}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result, err := json.Marshal(tt.data)
+			if err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+
+			if string(result) != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		expected person
+		data     string
+	}{
+		{person{}, `{}`},
+		{person{}, `{"name":null,"age":null}`},
+		{person{Name: Just("John")}, `{"name":"John"}`},
+		{person{Name: Just("John"), Age: Just(42)}, `{"name":"John","age":42}`},
+		{person{Name: Just(""), Age: Just(0)}, `{"name":"","age":0}`},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			var result person
+			if err := json.Unmarshal([]byte(tt.data), &result); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+
+			if show(result.Name) != show(tt.expected.Name) {
+				t.Errorf("expected %v, but got %v", show(tt.expected.Name), show(result.Name))
+			}
+
+			if show(result.Age) != show(tt.expected.Age) {
+				t.Errorf("expected %v, but got %v", show(tt.expected.Age), show(result.Age))
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	var result person
+	if err := json.Unmarshal([]byte(`{"age":"forty"}`), &result); err == nil {
+		t.Errorf("expected an error, but got nil")
+	}
+}
@@ -10,26 +10,24 @@
 // on the "happy path".
 package maybe
 
-import (
-	"reflect"
-)
-
-// Maybe monad data type representation. May or may not contain a pointer
-// value. Nothing is represented as a `nil` value internally.
-type Maybe[A any] struct{ val *A }
+// Maybe monad data type representation. May or may not contain a value. The
+// value is stored inline, so Maybe does not allocate and is comparable with
+// `==` when the contained type is comparable. Nothing always holds the zero
+// value of the type, so two Nothing values of the same type are equal.
+type Maybe[A any] struct {
+	val A
+	ok  bool
+}
 
 // Just is the return operation for Maybe monad that returns the representation
 // of existence of a value.
+//
+// Just does not inspect the given value, it is always present as is. Pointers
+// are not dereferenced, so Just of a pointer or a pointer-to-pointer contains
+// the pointer itself, and a `nil` pointer, map, slice, function, channel or
+// interface is a present `nil` value. Use From to treat `nil` as Nothing.
 func Just[A any](v A) Maybe[A] {
-	if reflect.ValueOf(v).Kind() == reflect.Ptr {
-		rv := reflect.ValueOf(v)
-		if rv.IsNil() {
-			panic("trying to insert `nil` an absent value as present `Just` value")
-		}
-		x := rv.Elem().Interface().(A)
-		return Maybe[A]{&x}
-	}
-	return Maybe[A]{&v}
+	return Maybe[A]{v, true}
 }
 
 // Nothing is the return operation for Maybe monad that returns the representation
@@ -40,12 +38,16 @@ func Nothing[A any]() Maybe[A] {
 
 // From is the return operation for Maybe monad that returns either Just a or
 // Nothing. Intended to be used with Go functions that return tuple as `val, ok`.
+//
+// From returns Nothing if `ok` is false, or if the value is a `nil` pointer,
+// map, slice, function, channel or interface. Only the outermost value is
+// inspected, so a non-nil pointer to a `nil` pointer is Just. Use FromWith to
+// change which values are treated as absent.
+//
+// Values of the predeclared boolean, numeric and string types are classified
+// without reflection, other types are inspected with the reflect package.
 func From[A any](val A, ok ...bool) Maybe[A] {
-	if is_nil(val) || (len(ok) > 0 && !ok[0]) {
-		return Nothing[A]()
-	}
-
-	return Just(val)
+	return from(Nilable, val, ok...)
 }
 
 // Map or the "bind" function takes the contents of the Maybe monad and passes
@@ -53,8 +55,8 @@ func From[A any](val A, ok ...bool) Maybe[A] {
 // monad as a result.
 func Map[A, B any](f func(A) B) func(Maybe[A]) Maybe[B] {
 	return func(m Maybe[A]) Maybe[B] {
-		if m.val != nil {
-			return Just(f(*m.val))
+		if m.ok {
+			return Just(f(m.val))
 		}
 		return Nothing[B]()
 	}
@@ -65,8 +67,8 @@ func Map[A, B any](f func(A) B) func(Maybe[A]) Maybe[B] {
 // (Maybe a -> Maybe b).
 func Fmap[A, B any](f func(A) Maybe[B]) func(Maybe[A]) Maybe[B] {
 	return func(m Maybe[A]) Maybe[B] {
-		if m.val != nil {
-			return f(*m.val)
+		if m.ok {
+			return f(m.val)
 		}
 		return Nothing[B]()
 	}
@@ -76,18 +78,9 @@ func Fmap[A, B any](f func(A) Maybe[B]) func(Maybe[A]) Maybe[B] {
 // value determined by the return type of b.
 func Match[A, B any](Nothing func() B, Just func(A) B) func(Maybe[A]) B {
 	return func(m Maybe[A]) B {
-		if m.val != nil {
-			return Just(*m.val)
+		if m.ok {
+			return Just(m.val)
 		}
 		return Nothing()
 	}
 }
-
-// internal
-func is_nil(v any) bool {
-	if reflect.ValueOf(v).Kind() == reflect.Ptr {
-		return reflect.ValueOf(v).IsNil()
-	}
-
-	return false
-}
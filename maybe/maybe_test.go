@@ -22,6 +22,13 @@ func inverse(x int) Maybe[float32] {
 	return Nothing[float32]()
 }
 
+func show[A any](m Maybe[A]) any {
+	return Match(
+		func() any { return "Nothing" },
+		func(v A) any { return v },
+	)(m)
+}
+
 func TestMap(t *testing.T) {
 	tests := []struct {
 		expected string
@@ -87,14 +94,15 @@ func TestFrom(t *testing.T) {
 	)
 	*y = 0
 	tests := []struct {
-		expected int
+		expected any
 		arg0     any
 		arg1     bool
 	}{
-		{},
+		{0, nil, false},
+		{0, nil, true},
 		{0, 1, false},
 		{0, x, true},
-		{0, y, true},
+		{y, y, true},
 		{1, 1, true},
 	}
 
@@ -108,8 +116,104 @@ func TestFrom(t *testing.T) {
 			)(result)
 
 			if res != tt.expected {
-				t.Errorf("expected %d, but got %d", tt.expected, res)
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestJust(t *testing.T) {
+	var (
+		x   = 1
+		p   = &x
+		np  *int
+		ptp = &np
+		err error
+		s   []int
+		m   map[string]int
+		f   func()
+		c   chan int
+	)
+
+	tests := []struct {
+		expected bool
+		result   any
+	}{
+		{true, Match(func() bool { return false }, func(v *int) bool { return v == p })(Just(p))},
+		{true, Match(func() bool { return false }, func(v *int) bool { return v == nil })(Just(np))},
+		{true, Match(func() bool { return false }, func(v **int) bool { return v == ptp && *v == nil })(Just(ptp))},
+		{true, Match(func() bool { return false }, func(v error) bool { return v == nil })(Just(err))},
+		{true, Match(func() bool { return false }, func(v []int) bool { return v == nil })(Just(s))},
+		{true, Match(func() bool { return false }, func(v map[string]int) bool { return v == nil })(Just(m))},
+		{true, Match(func() bool { return false }, func(v func()) bool { return v == nil })(Just(f))},
+		{true, Match(func() bool { return false }, func(v chan int) bool { return v == nil })(Just(c))},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, tt.result)
 			}
 		})
 	}
 }
+
+func TestFromInterface(t *testing.T) {
+	var err error
+
+	if result := show(From(err)); result != "Nothing" {
+		t.Errorf("expected %v, but got %v", "Nothing", result)
+	}
+
+	var np *int
+	if result := show(From(&np)); result != &np {
+		t.Errorf("expected %v, but got %v", &np, result)
+	}
+}
+
+var (
+	sinkInt   Maybe[int]
+	sinkFloat Maybe[float32]
+	sinkBool  bool
+)
+
+func BenchmarkJust(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInt = Just(i)
+	}
+}
+
+func BenchmarkMapFmap(b *testing.B) {
+	var (
+		double = Map(func(x int) int { return x * 2 })
+		inv    = Fmap(inverse)
+	)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkFloat = inv(double(Just(i)))
+	}
+}
+
+func BenchmarkMapChain(b *testing.B) {
+	var (
+		inc    = Map(func(x int) int { return x + 1 })
+		double = Map(func(x int) int { return x * 2 })
+		chain  = pipe.Pipe4(Just[int], inc, double, inc)
+	)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkInt = chain(i)
+	}
+}
+
+func BenchmarkEqual(b *testing.B) {
+	x := Just(1)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkBool = Equal(x, Just(i))
+	}
+}
@@ -0,0 +1,40 @@
+package maybe
+
+import "github.com/erikjuhani/go-fp/result"
+
+// ToResult converts the Maybe monad into a Result monad. Just is converted to
+// Ok and Nothing is converted to Err with the given error `err`. If `err` is
+// `nil`, Nothing is converted to Err with result.ErrNil
+func ToResult[A any](err error) func(Maybe[A]) result.Result[A] {
+	if err == nil {
+		err = result.ErrNil
+	}
+
+	return func(m Maybe[A]) result.Result[A] {
+		if m.ok {
+			return result.Ok(m.val)
+		}
+		return result.Err[A](err)
+	}
+}
+
+// FromResult converts the Result monad into a Maybe monad. Ok is converted to
+// Just and Err is converted to Nothing, discarding the error. FromResult is
+// the success projection of the Result monad, see Error for the error
+// projection
+func FromResult[A any](m result.Result[A]) Maybe[A] {
+	return result.Match(
+		func(error) Maybe[A] { return Nothing[A]() },
+		Just[A],
+	)(m)
+}
+
+// Error returns Just the error of the Result monad, or Nothing if the Result
+// monad is Ok. Error is the error projection of the Result monad, while
+// FromResult is the success projection
+func Error[A any](m result.Result[A]) Maybe[error] {
+	return result.Match(
+		Just[error],
+		func(A) Maybe[error] { return Nothing[error]() },
+	)(m)
+}
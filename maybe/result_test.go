@@ -0,0 +1,73 @@
+package maybe
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/result"
+)
+
+func TestToResult(t *testing.T) {
+	errNotFound := errors.New("not found")
+
+	tests := []struct {
+		expected any
+		err      error
+		data     Maybe[int]
+	}{
+		{1, errNotFound, Just(1)},
+		{errNotFound, errNotFound, Nothing[int]()},
+		{result.ErrNil, nil, Nothing[int]()},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			res := result.Match(
+				func(err error) any { return err },
+				func(v int) any { return v },
+			)(ToResult[int](tt.err)(tt.data))
+
+			if res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestFromResult(t *testing.T) {
+	tests := []struct {
+		expected any
+		data     result.Result[int]
+	}{
+		{1, result.Ok(1)},
+		{"Nothing", result.Err[int](errors.New("error"))},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if res := show(FromResult(tt.data)); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestError(t *testing.T) {
+	err := errors.New("error")
+
+	tests := []struct {
+		expected any
+		data     result.Result[int]
+	}{
+		{"Nothing", result.Ok(1)},
+		{err, result.Err[int](err)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if res := show(Error(tt.data)); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
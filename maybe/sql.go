@@ -0,0 +1,167 @@
+package maybe
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Scan implements the sql.Scanner interface. A `NULL` column is scanned as
+// Nothing and any other value is scanned as Just the value. When the contained
+// type implements sql.Scanner itself the scanning is delegated to it.
+func (m *Maybe[A]) Scan(src any) error {
+	if src == nil {
+		*m = Nothing[A]()
+		return nil
+	}
+
+	var v A
+	if err := scan(&v, src); err != nil {
+		return err
+	}
+	*m = Just(v)
+	return nil
+}
+
+// Value implements the driver.Valuer interface. Nothing is stored as `NULL`
+// and Just is stored as the contained value converted to a driver.Value.
+func (m Maybe[A]) Value() (driver.Value, error) {
+	if !m.ok {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(m.val)
+}
+
+// internal
+func scan(dest any, src any) error {
+	if s, ok := dest.(sql.Scanner); ok {
+		return s.Scan(src)
+	}
+
+	dv := reflect.ValueOf(dest).Elem()
+	sv := reflect.ValueOf(src)
+
+	switch s := src.(type) {
+	case []byte:
+		switch dv.Kind() {
+		case reflect.String:
+			dv.SetString(string(s))
+			return nil
+		case reflect.Interface:
+			if dv.NumMethod() == 0 {
+				dv.Set(reflect.ValueOf(append([]byte(nil), s...)))
+				return nil
+			}
+		case reflect.Slice:
+			if dv.Type().Elem().Kind() == reflect.Uint8 {
+				dv.SetBytes(append([]byte(nil), s...))
+				return nil
+			}
+		}
+		return parse(dv, string(s), src)
+	case string:
+		switch dv.Kind() {
+		case reflect.String:
+			dv.SetString(s)
+			return nil
+		case reflect.Interface:
+			if dv.NumMethod() == 0 {
+				dv.Set(sv)
+				return nil
+			}
+		case reflect.Slice:
+			if dv.Type().Elem().Kind() == reflect.Uint8 {
+				dv.SetBytes([]byte(s))
+				return nil
+			}
+		}
+		return parse(dv, s, src)
+	case time.Time:
+		if sv.Type().AssignableTo(dv.Type()) {
+			dv.Set(sv)
+			return nil
+		}
+		if dv.Kind() == reflect.String {
+			dv.SetString(s.Format(time.RFC3339Nano))
+			return nil
+		}
+	default:
+		if sv.Type().AssignableTo(dv.Type()) {
+			dv.Set(sv)
+			return nil
+		}
+		if isNumeric(sv.Kind()) || sv.Kind() == reflect.Bool {
+			if dv.Kind() == reflect.String {
+				dv.SetString(asString(sv))
+				return nil
+			}
+			// Numeric values are converted through their string representation
+			// and parsed with the bit size of the destination type, so that an
+			// overflow or a fractional part is reported instead of truncated
+			if isNumeric(sv.Kind()) && isNumeric(dv.Kind()) {
+				return parse(dv, asString(sv), src)
+			}
+		}
+	}
+
+	return fmt.Errorf("unsupported scan, storing driver.Value type %T into type %s", src, dv.Type())
+}
+
+func parse(dv reflect.Value, s string, src any) error {
+	switch dv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), err)
+		}
+		dv.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), err)
+		}
+		dv.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, dv.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), err)
+		}
+		dv.SetFloat(f)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, dv.Kind(), err)
+		}
+		dv.SetBool(b)
+	default:
+		return fmt.Errorf("unsupported scan, storing driver.Value type %T into type %s", src, dv.Type())
+	}
+	return nil
+}
+
+func asString(rv reflect.Value) string {
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(rv.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(rv.Float(), 'g', -1, rv.Type().Bits())
+	case reflect.Bool:
+		return strconv.FormatBool(rv.Bool())
+	}
+	return fmt.Sprint(rv.Interface())
+}
+
+func isNumeric(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,262 @@
+package maybe
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"testing"
+	"time"
+)
+
+// fakeDriver is an in-memory database/sql driver that returns a single row
+// containing the values stored under the queried key, and records the
+// arguments given to Exec under the executed key.
+type fakeDriver struct{ rows map[string][]driver.Value }
+
+type fakeConn struct{ d *fakeDriver }
+
+type fakeStmt struct {
+	c     *fakeConn
+	query string
+}
+
+type fakeRows struct {
+	vals []driver.Value
+	done bool
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{d}, nil }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) { return &fakeStmt{c, query}, nil }
+func (c *fakeConn) Close() error                              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error)                 { return nil, driver.ErrSkip }
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	s.c.d.rows[s.query] = args
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	return &fakeRows{vals: s.c.d.rows[s.query]}, nil
+}
+
+func (r *fakeRows) Columns() []string {
+	cols := make([]string, len(r.vals))
+	for i := range cols {
+		cols[i] = "col"
+	}
+	return cols
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+	r.done = true
+	copy(dest, r.vals)
+	return nil
+}
+
+var fake = &fakeDriver{rows: map[string][]driver.Value{}}
+
+func init() {
+	sql.Register("maybe_fake", fake)
+}
+
+func openFakeDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("maybe_fake", "")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func TestScan(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	fake.rows["SELECT scan"] = []driver.Value{
+		nil,
+		"hello",
+		[]byte("world"),
+		int64(42),
+		[]byte("7"),
+		float64(0.5),
+		true,
+		now,
+		nil,
+		"hello",
+		[]byte("world"),
+		now,
+	}
+
+	var (
+		nothing Maybe[string]
+		str     Maybe[string]
+		bytes   Maybe[string]
+		i64     Maybe[int64]
+		i       Maybe[int]
+		f       Maybe[float64]
+		b       Maybe[bool]
+		tm      Maybe[time.Time]
+		nullTm  Maybe[time.Time]
+		anyStr  Maybe[any]
+		anyByte Maybe[any]
+		anyTm   Maybe[any]
+	)
+
+	db := openFakeDB(t)
+	if err := db.QueryRow("SELECT scan").Scan(
+		&nothing, &str, &bytes, &i64, &i, &f, &b, &tm, &nullTm, &anyStr, &anyByte, &anyTm,
+	); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	tests := []struct {
+		expected any
+		result   any
+	}{
+		{"Nothing", show(nothing)},
+		{"hello", show(str)},
+		{"world", show(bytes)},
+		{int64(42), show(i64)},
+		{7, show(i)},
+		{0.5, show(f)},
+		{true, show(b)},
+		{now, show(tm)},
+		{"Nothing", show(nullTm)},
+		{"hello", show(anyStr)},
+		{"world", show(Map(func(v any) string { return string(v.([]byte)) })(anyByte))},
+		{now, show(anyTm)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, tt.result)
+			}
+		})
+	}
+}
+
+func TestScanInvalid(t *testing.T) {
+	fake.rows["SELECT invalid"] = []driver.Value{[]byte("forty")}
+
+	var m Maybe[int]
+
+	db := openFakeDB(t)
+	if err := db.QueryRow("SELECT invalid").Scan(&m); err == nil {
+		t.Errorf("expected an error, but got nil")
+	}
+}
+
+func scanAs[A any](src any) func() (any, error) {
+	return func() (any, error) {
+		var m Maybe[A]
+		err := m.Scan(src)
+		return show(m), err
+	}
+}
+
+func TestScanNumeric(t *testing.T) {
+	tests := []struct {
+		expected any
+		scan     func() (any, error)
+	}{
+		{int8(100), scanAs[int8](int64(100))},
+		{uint(1), scanAs[uint](int64(1))},
+		{2, scanAs[int](float64(2))},
+		{float32(0.5), scanAs[float32](float64(0.5))},
+		{nil, scanAs[int8](int64(300))},
+		{nil, scanAs[uint](int64(-1))},
+		{nil, scanAs[int](float64(0.9))},
+		{nil, scanAs[float32](float64(1e300))},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result, err := tt.scan()
+			if tt.expected == nil {
+				if err == nil {
+					t.Errorf("expected an error, but got %v", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestScanString(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 5, time.UTC)
+
+	tests := []struct {
+		expected string
+		src      any
+	}{
+		{"42", int64(42)},
+		{"0.5", float64(0.5)},
+		{"true", true},
+		{"2023-06-01T12:00:00.000000005Z", now},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			var m Maybe[string]
+			if err := m.Scan(tt.src); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if result := show(m); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestValue(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	db := openFakeDB(t)
+	if _, err := db.Exec(
+		"INSERT value",
+		Nothing[string](),
+		Just("hello"),
+		Just(42),
+		Just(0.5),
+		Just(true),
+		Just(now),
+		Nothing[time.Time](),
+	); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	tests := []struct {
+		expected driver.Value
+		result   driver.Value
+	}{
+		{nil, fake.rows["INSERT value"][0]},
+		{"hello", fake.rows["INSERT value"][1]},
+		{int64(42), fake.rows["INSERT value"][2]},
+		{0.5, fake.rows["INSERT value"][3]},
+		{true, fake.rows["INSERT value"][4]},
+		{now, fake.rows["INSERT value"][5]},
+		{nil, fake.rows["INSERT value"][6]},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, tt.result)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package maybe
+
+// Try calls function `f` and returns its value as Just. If function `f`
+// panics, the panic is recovered and Nothing is returned. Use result.Try to
+// keep the panic value
+func Try[A any](f func() A) (m Maybe[A]) {
+	defer func() {
+		if !m.ok {
+			recover()
+		}
+	}()
+
+	return Just(f())
+}
+
+// TryFunc lifts function `f` into a function that returns the value of `f` as
+// Just, or Nothing if `f` panics like Try
+func TryFunc[A, B any](f func(A) B) func(A) Maybe[B] {
+	return func(a A) Maybe[B] {
+		return Try(func() B { return f(a) })
+	}
+}
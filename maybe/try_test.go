@@ -0,0 +1,49 @@
+package maybe
+
+import (
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+func TestTry(t *testing.T) {
+	tests := []struct {
+		expected any
+		f        func() int
+	}{
+		{1, func() int { return 1 }},
+		{"Nothing", func() int { panic("boom") }},
+		{"Nothing", func() int { panic(nil) }},
+		{"Nothing", func() int { var s []int; return s[1] }},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(Try(tt.f)); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestTryFunc(t *testing.T) {
+	tests := []struct {
+		expected any
+		data     []int
+	}{
+		{"Nothing", []int{}},
+		{"Nothing", []int{0}},
+		{5, []int{2}},
+	}
+
+	div := TryFunc(func(x int) int { return 10 / x })
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(head[int], Fmap(div))(tt.data)
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package maybe
+
+import "github.com/erikjuhani/go-fp/tuple"
+
+// Zip combines two Maybe monads into a Maybe monad of a tuple.Pair. The result
+// is Just only if both Maybe monads are Just, otherwise Nothing
+func Zip[A, B any](a Maybe[A], b Maybe[B]) Maybe[tuple.Pair[A, B]] {
+	return Map2(tuple.NewPair[A, B])(a, b)
+}
+
+// Zip3 combines three Maybe monads into a Maybe monad of a tuple.Triple.
+// The result is Just only if all Maybe monads are Just, otherwise Nothing
+func Zip3[A, B, C any](a Maybe[A], b Maybe[B], c Maybe[C]) Maybe[tuple.Triple[A, B, C]] {
+	return Map3(tuple.NewTriple[A, B, C])(a, b, c)
+}
+
+// Ap applies the function contained in a Maybe monad to the value of the Maybe
+// monad `m`. The result is Just only if both the function and the value are
+// Just, otherwise Nothing
+func Ap[A, B any](m Maybe[A]) func(Maybe[func(A) B]) Maybe[B] {
+	return func(mf Maybe[func(A) B]) Maybe[B] {
+		return Fmap(func(f func(A) B) Maybe[B] { return Map(f)(m) })(mf)
+	}
+}
+
+// Map2 applies function `f` to the values of two Maybe monads if both are
+// Just. Otherwise Nothing is returned
+func Map2[A, B, C any](f func(A, B) C) func(Maybe[A], Maybe[B]) Maybe[C] {
+	return func(a Maybe[A], b Maybe[B]) Maybe[C] {
+		if !a.ok || !b.ok {
+			return Nothing[C]()
+		}
+		return Just(f(a.val, b.val))
+	}
+}
+
+// Map3 applies function `f` to the values of three Maybe monads if all are
+// Just. Otherwise Nothing is returned
+func Map3[A, B, C, D any](f func(A, B, C) D) func(Maybe[A], Maybe[B], Maybe[C]) Maybe[D] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C]) Maybe[D] {
+		if !a.ok || !b.ok || !c.ok {
+			return Nothing[D]()
+		}
+		return Just(f(a.val, b.val, c.val))
+	}
+}
+
+// Map4 applies function `f` to the values of four Maybe monads if all are
+// Just. Otherwise Nothing is returned
+func Map4[A, B, C, D, E any](f func(A, B, C, D) E) func(Maybe[A], Maybe[B], Maybe[C], Maybe[D]) Maybe[E] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C], d Maybe[D]) Maybe[E] {
+		if !a.ok || !b.ok || !c.ok || !d.ok {
+			return Nothing[E]()
+		}
+		return Just(f(a.val, b.val, c.val, d.val))
+	}
+}
+
+// Map5 applies function `f` to the values of five Maybe monads if all are
+// Just. Otherwise Nothing is returned
+func Map5[A, B, C, D, E, F any](f func(A, B, C, D, E) F) func(Maybe[A], Maybe[B], Maybe[C], Maybe[D], Maybe[E]) Maybe[F] {
+	return func(a Maybe[A], b Maybe[B], c Maybe[C], d Maybe[D], e Maybe[E]) Maybe[F] {
+		if !a.ok || !b.ok || !c.ok || !d.ok || !e.ok {
+			return Nothing[F]()
+		}
+		return Just(f(a.val, b.val, c.val, d.val, e.val))
+	}
+}
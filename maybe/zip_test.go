@@ -0,0 +1,100 @@
+package maybe
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/tuple"
+)
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		expected any
+		result   Maybe[tuple.Pair[int, string]]
+	}{
+		{tuple.NewPair(1, "one"), Zip(Just(1), Just("one"))},
+		{"Nothing", Zip(Nothing[int](), Just("one"))},
+		{"Nothing", Zip(Just(1), Nothing[string]())},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(tt.result); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestZip3(t *testing.T) {
+	tests := []struct {
+		expected any
+		result   Maybe[tuple.Triple[int, string, bool]]
+	}{
+		{tuple.NewTriple(1, "one", true), Zip3(Just(1), Just("one"), Just(true))},
+		{"Nothing", Zip3(Just(1), Just("one"), Nothing[bool]())},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(tt.result); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMapN(t *testing.T) {
+	var (
+		sum2    = func(a, b int) int { return a + b }
+		sum3    = func(a, b, c int) int { return a + b + c }
+		sum4    = func(a, b, c, d int) int { return a + b + c + d }
+		sum5    = func(a, b, c, d, e int) int { return a + b + c + d + e }
+		one     = Just(1)
+		nothing = Nothing[int]()
+	)
+
+	tests := []struct {
+		expected any
+		result   Maybe[int]
+	}{
+		{2, Map2(sum2)(one, one)},
+		{"Nothing", Map2(sum2)(one, nothing)},
+		{3, Map3(sum3)(one, one, one)},
+		{"Nothing", Map3(sum3)(nothing, one, one)},
+		{4, Map4(sum4)(one, one, one, one)},
+		{"Nothing", Map4(sum4)(one, one, nothing, one)},
+		{5, Map5(sum5)(one, one, one, one, one)},
+		{"Nothing", Map5(sum5)(one, one, one, one, nothing)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(tt.result); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAp(t *testing.T) {
+	double := func(x int) string { return fmt.Sprint(x * 2) }
+
+	tests := []struct {
+		expected any
+		f        Maybe[func(int) string]
+		m        Maybe[int]
+	}{
+		{"4", Just(double), Just(2)},
+		{"Nothing", Just(double), Nothing[int]()},
+		{"Nothing", Nothing[func(int) string](), Just(2)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(Ap[int, string](tt.m)(tt.f)); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
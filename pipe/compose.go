@@ -0,0 +1,176 @@
+// Code generated by "go run ./internal/gen -n 12"; DO NOT EDIT.
+
+package pipe
+
+// Compose takes one function as an argument and returns a function that takes a
+// value `a` and returns the output `b` of the given function
+func Compose[A, B any](
+	ab func(A) B,
+) func(A) B {
+	return Pipe(ab)
+}
+
+// Compose2 takes two functions as arguments and returns a function that takes a
+// value `a` and returns the output `c` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose2[A, B, C any](
+	bc func(B) C,
+	ab func(A) B,
+) func(A) C {
+	return Pipe2(ab, bc)
+}
+
+// Compose3 takes three functions as arguments and returns a function that takes
+// a value `a` and returns the output `d` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose3[A, B, C, D any](
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) D {
+	return Pipe3(ab, bc, cd)
+}
+
+// Compose4 takes four functions as arguments and returns a function that takes
+// a value `a` and returns the output `e` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose4[A, B, C, D, E any](
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) E {
+	return Pipe4(ab, bc, cd, de)
+}
+
+// Compose5 takes five functions as arguments and returns a function that takes
+// a value `a` and returns the output `f` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose5[A, B, C, D, E, F any](
+	ef func(E) F,
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) F {
+	return Pipe5(ab, bc, cd, de, ef)
+}
+
+// Compose6 takes six functions as arguments and returns a function that takes a
+// value `a` and returns the output `g` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose6[A, B, C, D, E, F, G any](
+	fg func(F) G,
+	ef func(E) F,
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) G {
+	return Pipe6(ab, bc, cd, de, ef, fg)
+}
+
+// Compose7 takes seven functions as arguments and returns a function that takes
+// a value `a` and returns the output `h` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose7[A, B, C, D, E, F, G, H any](
+	gh func(G) H,
+	fg func(F) G,
+	ef func(E) F,
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) H {
+	return Pipe7(ab, bc, cd, de, ef, fg, gh)
+}
+
+// Compose8 takes eight functions as arguments and returns a function that takes
+// a value `a` and returns the output `i` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose8[A, B, C, D, E, F, G, H, I any](
+	hi func(H) I,
+	gh func(G) H,
+	fg func(F) G,
+	ef func(E) F,
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) I {
+	return Pipe8(ab, bc, cd, de, ef, fg, gh, hi)
+}
+
+// Compose9 takes nine functions as arguments and returns a function that takes
+// a value `a` and returns the output `j` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose9[A, B, C, D, E, F, G, H, I, J any](
+	ij func(I) J,
+	hi func(H) I,
+	gh func(G) H,
+	fg func(F) G,
+	ef func(E) F,
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) J {
+	return Pipe9(ab, bc, cd, de, ef, fg, gh, hi, ij)
+}
+
+// Compose10 takes ten functions as arguments and returns a function that takes
+// a value `a` and returns the output `k` obtained by applying the function
+// arguments in a sequence from right to left
+func Compose10[A, B, C, D, E, F, G, H, I, J, K any](
+	jk func(J) K,
+	ij func(I) J,
+	hi func(H) I,
+	gh func(G) H,
+	fg func(F) G,
+	ef func(E) F,
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) K {
+	return Pipe10(ab, bc, cd, de, ef, fg, gh, hi, ij, jk)
+}
+
+// Compose11 takes eleven functions as arguments and returns a function that
+// takes a value `a` and returns the output `l` obtained by applying the
+// function arguments in a sequence from right to left
+func Compose11[A, B, C, D, E, F, G, H, I, J, K, L any](
+	kl func(K) L,
+	jk func(J) K,
+	ij func(I) J,
+	hi func(H) I,
+	gh func(G) H,
+	fg func(F) G,
+	ef func(E) F,
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) L {
+	return Pipe11(ab, bc, cd, de, ef, fg, gh, hi, ij, jk, kl)
+}
+
+// Compose12 takes twelve functions as arguments and returns a function that
+// takes a value `a` and returns the output `m` obtained by applying the
+// function arguments in a sequence from right to left
+func Compose12[A, B, C, D, E, F, G, H, I, J, K, L, M any](
+	lm func(L) M,
+	kl func(K) L,
+	jk func(J) K,
+	ij func(I) J,
+	hi func(H) I,
+	gh func(G) H,
+	fg func(F) G,
+	ef func(E) F,
+	de func(D) E,
+	cd func(C) D,
+	bc func(B) C,
+	ab func(A) B,
+) func(A) M {
+	return Pipe12(ab, bc, cd, de, ef, fg, gh, hi, ij, jk, kl, lm)
+}
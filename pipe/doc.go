@@ -0,0 +1,28 @@
+// Provides `Pipe` function for function composition and chaining
+//
+// `Pipe` function composes functions in a sequence from left to right and
+// takes the initial data as the first argument when invoking the resulting
+// composed function, as demonstrated by `Pipe2(fn1, fn2)(initialdata)`
+//
+// `PipeR` function composes functions that can fail, such as functions that
+// return a tuple `(T, error)`, and short-circuits on the first error
+//
+// `PipeCtx` function composes context-aware functions that can fail, checks
+// the context for cancellation between the functions and recovers panics
+//
+// `PipeObserved` function composes functions like `Pipe` and notifies an
+// Observer when each function starts and ends
+//
+// `Compose` function composes functions in a sequence from right to left, as
+// demonstrated by `Compose2(fn2, fn1)(initialdata)`
+//
+// To ensure type safety in go, it is necessary to define separate `Pipe`
+// functions for each specific number of arguments. for instance, if there are
+// three pipeable arguments, we would call `Pipe3(fn1, fn2, fn3)`.
+//
+// The `Pipe`, `PipeR`, `PipeCtx`, `PipeObserved` and `Compose` function
+// families are generated up to the arity given to the generator. To change the
+// maximum arity, change the `-n` flag below and run `go generate`.
+package pipe
+
+//go:generate go run ./internal/gen -n 12
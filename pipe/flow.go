@@ -0,0 +1,15 @@
+package pipe
+
+// Flow takes any number of type-erased functions as arguments and returns a
+// function that takes a value `a` and returns the output obtained by applying
+// the function arguments in a sequence. Flow is not limited by arity like
+// `PipeN`, but it gives up type safety as the values are passed as `any`,
+// which makes the caller responsible for the type assertions
+func Flow(fns ...func(any) any) func(any) any {
+	return func(a any) any {
+		for _, f := range fns {
+			a = f(a)
+		}
+		return a
+	}
+}
@@ -0,0 +1,1779 @@
+// Code generated by "go run ./internal/gen -n 12"; DO NOT EDIT.
+
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func increment(x int) int {
+	return x + 1
+}
+
+func incrementR(x int) (int, error) {
+	return x + 1, nil
+}
+
+func fail(int) (int, error) {
+	return 0, errors.New("failure")
+}
+
+func incrementCtx(_ context.Context, x int) (int, error) {
+	return x + 1, nil
+}
+
+func panics(context.Context, int) (int, error) {
+	panic("failure")
+}
+
+type recorder struct {
+	started []Stage
+	ended   []Stage
+	errs    []error
+}
+
+func (r *recorder) OnStageStart(s Stage) {
+	r.started = append(r.started, s)
+}
+
+func (r *recorder) OnStageEnd(s Stage, _ time.Duration, err error) {
+	r.ended = append(r.ended, s)
+	r.errs = append(r.errs, err)
+}
+
+func TestGeneratedPipe(t *testing.T) {
+	expected := 1
+	result := Pipe(
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR(t *testing.T) {
+	expected := 1
+	result, err := PipeR(
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeRError(t *testing.T) {
+	expected := 1
+	_, err := PipeR(
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx(t *testing.T) {
+	expected := 1
+	result, err := PipeCtx(
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtxPanic(t *testing.T) {
+	expected := 1
+	_, err := PipeCtx(
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved(t *testing.T) {
+	expected := 1
+	o := &recorder{}
+	result := PipeObserved(
+		o,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose(t *testing.T) {
+	expected := 1
+	result := Compose(
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe2(t *testing.T) {
+	expected := 2
+	result := Pipe2(
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR2(t *testing.T) {
+	expected := 2
+	result, err := PipeR2(
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR2Error(t *testing.T) {
+	expected := 2
+	_, err := PipeR2(
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx2(t *testing.T) {
+	expected := 2
+	result, err := PipeCtx2(
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx2Panic(t *testing.T) {
+	expected := 2
+	_, err := PipeCtx2(
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved2(t *testing.T) {
+	expected := 2
+	o := &recorder{}
+	result := PipeObserved2(
+		o,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose2(t *testing.T) {
+	expected := 2
+	result := Compose2(
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe3(t *testing.T) {
+	expected := 3
+	result := Pipe3(
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR3(t *testing.T) {
+	expected := 3
+	result, err := PipeR3(
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR3Error(t *testing.T) {
+	expected := 3
+	_, err := PipeR3(
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx3(t *testing.T) {
+	expected := 3
+	result, err := PipeCtx3(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx3Panic(t *testing.T) {
+	expected := 3
+	_, err := PipeCtx3(
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved3(t *testing.T) {
+	expected := 3
+	o := &recorder{}
+	result := PipeObserved3(
+		o,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose3(t *testing.T) {
+	expected := 3
+	result := Compose3(
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe4(t *testing.T) {
+	expected := 4
+	result := Pipe4(
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR4(t *testing.T) {
+	expected := 4
+	result, err := PipeR4(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR4Error(t *testing.T) {
+	expected := 4
+	_, err := PipeR4(
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx4(t *testing.T) {
+	expected := 4
+	result, err := PipeCtx4(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx4Panic(t *testing.T) {
+	expected := 4
+	_, err := PipeCtx4(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved4(t *testing.T) {
+	expected := 4
+	o := &recorder{}
+	result := PipeObserved4(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose4(t *testing.T) {
+	expected := 4
+	result := Compose4(
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe5(t *testing.T) {
+	expected := 5
+	result := Pipe5(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR5(t *testing.T) {
+	expected := 5
+	result, err := PipeR5(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR5Error(t *testing.T) {
+	expected := 5
+	_, err := PipeR5(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx5(t *testing.T) {
+	expected := 5
+	result, err := PipeCtx5(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx5Panic(t *testing.T) {
+	expected := 5
+	_, err := PipeCtx5(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved5(t *testing.T) {
+	expected := 5
+	o := &recorder{}
+	result := PipeObserved5(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose5(t *testing.T) {
+	expected := 5
+	result := Compose5(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe6(t *testing.T) {
+	expected := 6
+	result := Pipe6(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR6(t *testing.T) {
+	expected := 6
+	result, err := PipeR6(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR6Error(t *testing.T) {
+	expected := 6
+	_, err := PipeR6(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx6(t *testing.T) {
+	expected := 6
+	result, err := PipeCtx6(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx6Panic(t *testing.T) {
+	expected := 6
+	_, err := PipeCtx6(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved6(t *testing.T) {
+	expected := 6
+	o := &recorder{}
+	result := PipeObserved6(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose6(t *testing.T) {
+	expected := 6
+	result := Compose6(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe7(t *testing.T) {
+	expected := 7
+	result := Pipe7(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR7(t *testing.T) {
+	expected := 7
+	result, err := PipeR7(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR7Error(t *testing.T) {
+	expected := 7
+	_, err := PipeR7(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx7(t *testing.T) {
+	expected := 7
+	result, err := PipeCtx7(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx7Panic(t *testing.T) {
+	expected := 7
+	_, err := PipeCtx7(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved7(t *testing.T) {
+	expected := 7
+	o := &recorder{}
+	result := PipeObserved7(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose7(t *testing.T) {
+	expected := 7
+	result := Compose7(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe8(t *testing.T) {
+	expected := 8
+	result := Pipe8(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR8(t *testing.T) {
+	expected := 8
+	result, err := PipeR8(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR8Error(t *testing.T) {
+	expected := 8
+	_, err := PipeR8(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx8(t *testing.T) {
+	expected := 8
+	result, err := PipeCtx8(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx8Panic(t *testing.T) {
+	expected := 8
+	_, err := PipeCtx8(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved8(t *testing.T) {
+	expected := 8
+	o := &recorder{}
+	result := PipeObserved8(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose8(t *testing.T) {
+	expected := 8
+	result := Compose8(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe9(t *testing.T) {
+	expected := 9
+	result := Pipe9(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR9(t *testing.T) {
+	expected := 9
+	result, err := PipeR9(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR9Error(t *testing.T) {
+	expected := 9
+	_, err := PipeR9(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx9(t *testing.T) {
+	expected := 9
+	result, err := PipeCtx9(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx9Panic(t *testing.T) {
+	expected := 9
+	_, err := PipeCtx9(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved9(t *testing.T) {
+	expected := 9
+	o := &recorder{}
+	result := PipeObserved9(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose9(t *testing.T) {
+	expected := 9
+	result := Compose9(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe10(t *testing.T) {
+	expected := 10
+	result := Pipe10(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR10(t *testing.T) {
+	expected := 10
+	result, err := PipeR10(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR10Error(t *testing.T) {
+	expected := 10
+	_, err := PipeR10(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx10(t *testing.T) {
+	expected := 10
+	result, err := PipeCtx10(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx10Panic(t *testing.T) {
+	expected := 10
+	_, err := PipeCtx10(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved10(t *testing.T) {
+	expected := 10
+	o := &recorder{}
+	result := PipeObserved10(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose10(t *testing.T) {
+	expected := 10
+	result := Compose10(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe11(t *testing.T) {
+	expected := 11
+	result := Pipe11(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR11(t *testing.T) {
+	expected := 11
+	result, err := PipeR11(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR11Error(t *testing.T) {
+	expected := 11
+	_, err := PipeR11(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx11(t *testing.T) {
+	expected := 11
+	result, err := PipeCtx11(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx11Panic(t *testing.T) {
+	expected := 11
+	_, err := PipeCtx11(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved11(t *testing.T) {
+	expected := 11
+	o := &recorder{}
+	result := PipeObserved11(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose11(t *testing.T) {
+	expected := 11
+	result := Compose11(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipe12(t *testing.T) {
+	expected := 12
+	result := Pipe12(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR12(t *testing.T) {
+	expected := 12
+	result, err := PipeR12(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeR12Error(t *testing.T) {
+	expected := 12
+	_, err := PipeR12(
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		incrementR,
+		fail,
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGeneratedPipeCtx12(t *testing.T) {
+	expected := 12
+	result, err := PipeCtx12(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGeneratedPipeCtx12Panic(t *testing.T) {
+	expected := 12
+	_, err := PipeCtx12(
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		incrementCtx,
+		panics,
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGeneratedPipeObserved12(t *testing.T) {
+	expected := 12
+	o := &recorder{}
+	result := PipeObserved12(
+		o,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGeneratedCompose12(t *testing.T) {
+	expected := 12
+	result := Compose12(
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+		increment,
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
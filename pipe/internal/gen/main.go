@@ -0,0 +1,498 @@
+// Command gen generates the `Pipe`, `PipeR`, `PipeCtx`, `PipeObserved` and
+// `Compose` function families of the pipe package, and the tests for them, up
+// to the given arity.
+//
+// Usage:
+//
+//	go run ./internal/gen -n 12
+//
+// The generator is invoked with `go generate` from the pipe package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// letters are used as the type parameters of the generated functions, which
+// limits the maximum arity to one less than the amount of letters
+const letters = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+var numbers = []string{
+	"zero", "one", "two", "three", "four", "five", "six", "seven", "eight",
+	"nine", "ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen",
+	"sixteen", "seventeen", "eighteen", "nineteen", "twenty", "twenty-one",
+	"twenty-two", "twenty-three", "twenty-four", "twenty-five",
+}
+
+// fn describes a single generated function of arity `N`
+type fn struct {
+	N int
+}
+
+// Name returns the name of the function with the given prefix. The function
+// of arity one has no numeric suffix
+func (f fn) Name(prefix string) string {
+	if f.N == 1 {
+		return prefix
+	}
+	return fmt.Sprintf("%s%d", prefix, f.N)
+}
+
+// Doc returns the doc comment text of the function with the given prefix
+func (f fn) Doc(prefix, order string) string {
+	if f.N == 1 {
+		return fmt.Sprintf(
+			"%s takes one function as an argument and returns a function that takes a value `a` and returns the output `%s` of the given function",
+			f.Name(prefix), strings.ToLower(f.Out()),
+		)
+	}
+	return fmt.Sprintf(
+		"%s takes %s functions as arguments and returns a function that takes a value `a` and returns the output `%s` obtained by applying the function arguments %s",
+		f.Name(prefix), numbers[f.N], strings.ToLower(f.Out()), order,
+	)
+}
+
+// TypeParams returns the type parameters of the function, e.g. `A, B, C`
+func (f fn) TypeParams() string {
+	return strings.Join(strings.Split(letters[:f.N+1], ""), ", ")
+}
+
+// Out returns the output type of the function
+func (f fn) Out() string {
+	return string(letters[f.N])
+}
+
+// Params returns the function parameters in left to right order
+func (f fn) Params() []string {
+	params := make([]string, f.N)
+	for i := range params {
+		params[i] = param(i)
+	}
+	return params
+}
+
+// ReversedParams returns the function parameters in right to left order
+func (f fn) ReversedParams() []string {
+	params := f.Params()
+	for i, j := 0, len(params)-1; i < j; i, j = i+1, j-1 {
+		params[i], params[j] = params[j], params[i]
+	}
+	return params
+}
+
+// Prev returns the function with one less arity
+func (f fn) Prev() fn {
+	return fn{f.N - 1}
+}
+
+// Last returns the last function parameter
+func (f fn) Last() string {
+	return param(f.N - 1)
+}
+
+// Init returns the function parameters without the last one joined by comma
+func (f fn) Init() string {
+	return strings.Join(f.Params()[:f.N-1], ", ")
+}
+
+// DocR returns the doc comment text of the error-aware function with the
+// given prefix
+func (f fn) DocR(prefix string) string {
+	if f.N == 1 {
+		return fmt.Sprintf(
+			"%s takes one function that can fail as an argument and returns a function that takes a value `a` and returns the output `%s` of the given function or a *StageError",
+			f.Name(prefix), strings.ToLower(f.Out()),
+		)
+	}
+	return fmt.Sprintf(
+		"%s takes %s functions that can fail as arguments and returns a function that takes a value `a` and returns the output `%s` obtained by applying the function arguments in a sequence. The sequence is short-circuited on the first error, which is returned as a *StageError",
+		f.Name(prefix), numbers[f.N], strings.ToLower(f.Out()),
+	)
+}
+
+// DocCtx returns the doc comment text of the context-aware function with the
+// given prefix
+func (f fn) DocCtx(prefix string) string {
+	if f.N == 1 {
+		return fmt.Sprintf(
+			"%s takes one context-aware function as an argument and returns a function that takes a context and a value `a` and returns the output `%s` of the given function. A cancelled context or a panic is returned as a *StageError",
+			f.Name(prefix), strings.ToLower(f.Out()),
+		)
+	}
+	return fmt.Sprintf(
+		"%s takes %s context-aware functions as arguments and returns a function that takes a context and a value `a` and returns the output `%s` obtained by applying the function arguments in a sequence. The context is checked for cancellation before each function, and a panic is recovered into a *PanicError. The sequence is short-circuited on the first error, which is returned as a *StageError",
+		f.Name(prefix), numbers[f.N], strings.ToLower(f.Out()),
+	)
+}
+
+// DocObserved returns the doc comment text of the observed function with the
+// given prefix
+func (f fn) DocObserved(prefix string) string {
+	if f.N == 1 {
+		return fmt.Sprintf(
+			"%s takes an observer and one function as arguments and returns a function that takes a value `a` and returns the output `%s` of the given function. The observer is notified when the function starts and ends. A `nil` observer is ignored",
+			f.Name(prefix), strings.ToLower(f.Out()),
+		)
+	}
+	return fmt.Sprintf(
+		"%s takes an observer and %s functions as arguments and returns a function that takes a value `a` and returns the output `%s` obtained by applying the function arguments in a sequence. The observer is notified when each function starts and ends. A `nil` observer is ignored",
+		f.Name(prefix), numbers[f.N], strings.ToLower(f.Out()),
+	)
+}
+
+// comment wraps the given text into a line comment of at most 80 columns
+func comment(text string) string {
+	var (
+		lines []string
+		line  = "//"
+	)
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 80 {
+			lines = append(lines, line)
+			line = "//"
+		}
+		line += " " + word
+	}
+	return strings.Join(append(lines, line), "\n")
+}
+
+func param(i int) string {
+	return strings.ToLower(letters[i : i+2])
+}
+
+func signature(i int) string {
+	return fmt.Sprintf("func(%c) %c", letters[i], letters[i+1])
+}
+
+func signatureCtx(i int) string {
+	return fmt.Sprintf("func(context.Context, %c) (%c, error)", letters[i], letters[i+1])
+}
+
+func signatureR(i int) string {
+	return fmt.Sprintf("func(%c) (%c, error)", letters[i], letters[i+1])
+}
+
+const header = `// Code generated by "go run ./internal/gen -n {{.N}}"; DO NOT EDIT.
+
+`
+
+const pipeTemplate = header + `package pipe
+{{range .Fns}}
+{{comment (.Doc "Pipe" "in a sequence")}}
+func {{.Name "Pipe"}}[{{.TypeParams}} any](
+{{- range $i, $p := .Params}}
+	{{$p}} {{sig $i}},
+{{- end}}
+) func(A) {{.Out}} {
+{{- if eq .N 1}}
+	return func(a A) {{.Out}} { return {{.Last}}(a) }
+{{- else}}
+	return func(a A) {{.Out}} { return {{.Last}}({{.Prev.Name "Pipe"}}({{.Init}})(a)) }
+{{- end}}
+}
+{{end}}`
+
+const composeTemplate = header + `package pipe
+{{range .Fns}}
+{{comment (.Doc "Compose" "in a sequence from right to left")}}
+func {{.Name "Compose"}}[{{.TypeParams}} any](
+{{- $n := .N}}
+{{- range $i, $p := .ReversedParams}}
+	{{$p}} {{rsig $n $i}},
+{{- end}}
+) func(A) {{.Out}} {
+	return {{.Name "Pipe"}}({{join .Params ", "}})
+}
+{{end}}`
+
+const pipeRTemplate = header + `package pipe
+{{range .Fns}}
+{{comment (.DocR "PipeR")}}
+func {{.Name "PipeR"}}[{{.TypeParams}} any](
+{{- range $i, $p := .Params}}
+	{{$p}} {{sigR $i}},
+{{- end}}
+) func(A) ({{.Out}}, error) {
+{{- if eq .N 1}}
+	return stage(1, {{.Last}})
+{{- else}}
+	return func(a A) ({{.Out}}, error) {
+		{{lower .Prev.Out}}, err := {{.Prev.Name "PipeR"}}({{.Init}})(a)
+		if err != nil {
+			return *new({{.Out}}), err
+		}
+		return stage({{.N}}, {{.Last}})({{lower .Prev.Out}})
+	}
+{{- end}}
+}
+{{end}}`
+
+const pipeCtxTemplate = header + `package pipe
+
+import "context"
+{{range .Fns}}
+{{comment (.DocCtx "PipeCtx")}}
+func {{.Name "PipeCtx"}}[{{.TypeParams}} any](
+{{- range $i, $p := .Params}}
+	{{$p}} {{sigCtx $i}},
+{{- end}}
+) func(context.Context, A) ({{.Out}}, error) {
+{{- if eq .N 1}}
+	return stageCtx(1, {{.Last}})
+{{- else}}
+	prev, last := {{.Prev.Name "PipeCtx"}}({{.Init}}), stageCtx({{.N}}, {{.Last}})
+	return func(ctx context.Context, a A) ({{.Out}}, error) {
+		{{lower .Prev.Out}}, err := prev(ctx, a)
+		if err != nil {
+			return *new({{.Out}}), err
+		}
+		return last(ctx, {{lower .Prev.Out}})
+	}
+{{- end}}
+}
+{{end}}`
+
+const pipeObservedTemplate = header + `package pipe
+{{range .Fns}}
+{{comment (.DocObserved "PipeObserved")}}
+func {{.Name "PipeObserved"}}[{{.TypeParams}} any](
+	o Observer,
+{{- range $i, $p := .Params}}
+	{{$p}} {{sig $i}},
+{{- end}}
+) func(A) {{.Out}} {
+{{- if eq .N 1}}
+	return observe(o, 1, {{.Last}})
+{{- else}}
+	prev, last := {{.Prev.Name "PipeObserved"}}(o, {{.Init}}), observe(o, {{.N}}, {{.Last}})
+	return func(a A) {{.Out}} { return last(prev(a)) }
+{{- end}}
+}
+{{end}}`
+
+const testTemplate = header + `package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func increment(x int) int {
+	return x + 1
+}
+
+func incrementR(x int) (int, error) {
+	return x + 1, nil
+}
+
+func fail(int) (int, error) {
+	return 0, errors.New("failure")
+}
+
+func incrementCtx(_ context.Context, x int) (int, error) {
+	return x + 1, nil
+}
+
+func panics(context.Context, int) (int, error) {
+	panic("failure")
+}
+
+type recorder struct {
+	started []Stage
+	ended   []Stage
+	errs    []error
+}
+
+func (r *recorder) OnStageStart(s Stage) {
+	r.started = append(r.started, s)
+}
+
+func (r *recorder) OnStageEnd(s Stage, _ time.Duration, err error) {
+	r.ended = append(r.ended, s)
+	r.errs = append(r.errs, err)
+}
+{{range .Fns}}
+func TestGenerated{{.Name "Pipe"}}(t *testing.T) {
+	expected := {{.N}}
+	result := {{.Name "Pipe"}}(
+{{- range .Params}}
+		increment,
+{{- end}}
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGenerated{{.Name "PipeR"}}(t *testing.T) {
+	expected := {{.N}}
+	result, err := {{.Name "PipeR"}}(
+{{- range .Params}}
+		incrementR,
+{{- end}}
+	)(0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGenerated{{.Name "PipeR"}}Error(t *testing.T) {
+	expected := {{.N}}
+	{{- $last := dec .N}}
+	_, err := {{.Name "PipeR"}}(
+{{- range $i, $p := .Params}}
+		{{if eq $i $last}}fail{{else}}incrementR{{end}},
+{{- end}}
+	)(0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+}
+
+func TestGenerated{{.Name "PipeCtx"}}(t *testing.T) {
+	expected := {{.N}}
+	result, err := {{.Name "PipeCtx"}}(
+{{- range .Params}}
+		incrementCtx,
+{{- end}}
+	)(context.Background(), 0)
+
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+
+func TestGenerated{{.Name "PipeCtx"}}Panic(t *testing.T) {
+	expected := {{.N}}
+	{{- $last := dec .N}}
+	_, err := {{.Name "PipeCtx"}}(
+{{- range $i, $p := .Params}}
+		{{if eq $i $last}}panics{{else}}incrementCtx{{end}},
+{{- end}}
+	)(context.Background(), 0)
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %v", err)
+	}
+
+	if stageErr.Stage != expected {
+		t.Errorf("expected %d, but got %d", expected, stageErr.Stage)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+}
+
+func TestGenerated{{.Name "PipeObserved"}}(t *testing.T) {
+	expected := {{.N}}
+	o := &recorder{}
+	result := {{.Name "PipeObserved"}}(
+		o,
+{{- range .Params}}
+		increment,
+{{- end}}
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+
+	if len(o.ended) != expected {
+		t.Errorf("expected %d, but got %d", expected, len(o.ended))
+	}
+}
+
+func TestGenerated{{.Name "Compose"}}(t *testing.T) {
+	expected := {{.N}}
+	result := {{.Name "Compose"}}(
+{{- range .Params}}
+		increment,
+{{- end}}
+	)(0)
+
+	if result != expected {
+		t.Errorf("expected %d, but got %d", expected, result)
+	}
+}
+{{end}}`
+
+func main() {
+	n := flag.Int("n", 12, "maximum arity of the generated functions")
+	flag.Parse()
+
+	if *n < 1 || *n > len(letters)-1 {
+		log.Fatalf("arity must be between 1 and %d, got %d", len(letters)-1, *n)
+	}
+
+	data := struct {
+		N   int
+		Fns []fn
+	}{N: *n}
+	for i := 1; i <= *n; i++ {
+		data.Fns = append(data.Fns, fn{i})
+	}
+
+	funcs := template.FuncMap{
+		"comment": comment,
+		"join":    strings.Join,
+		"sig":     signature,
+		"rsig":    func(n, i int) string { return signature(n - 1 - i) },
+		"sigR":    signatureR,
+		"sigCtx":  signatureCtx,
+		"lower":   strings.ToLower,
+		"dec":     func(i int) int { return i - 1 },
+	}
+
+	files := map[string]string{
+		"pipe.go":           pipeTemplate,
+		"compose.go":        composeTemplate,
+		"piper.go":          pipeRTemplate,
+		"pipectx.go":        pipeCtxTemplate,
+		"pipeobserved.go":   pipeObservedTemplate,
+		"generated_test.go": testTemplate,
+	}
+
+	for name, text := range files {
+		var buf bytes.Buffer
+		if err := template.Must(template.New(name).Funcs(funcs).Parse(text)).Execute(&buf, data); err != nil {
+			log.Fatalf("executing template %s: %s", name, err)
+		}
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatalf("formatting %s: %s\n%s", name, err, buf.Bytes())
+		}
+
+		if err := os.WriteFile(name, src, 0o644); err != nil {
+			log.Fatalf("writing %s: %s", name, err)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package pipe
+
+import (
+	"context"
+	"reflect"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// Stage describes a single function argument of a pipe. Index is the position
+// of the function argument starting from one, and Name is the name of the
+// function as reported by the runtime
+type Stage struct {
+	Index int
+	Name  string
+}
+
+// Observer is notified when a function argument of an observed pipe starts
+// and ends. OnStageEnd receives the duration of the function and the error
+// returned by it, or a *PanicError if the function panicked. Observer can be
+// used to plug in tracing, timing or logging without modifying the composed
+// functions
+type Observer interface {
+	OnStageStart(s Stage)
+	OnStageEnd(s Stage, d time.Duration, err error)
+}
+
+type observerKey struct{}
+
+// WithObserver returns a copy of the context `ctx` that carries the observer
+// `o`. The `PipeCtx` functions notify the observer carried by the context
+// passed to them
+func WithObserver(ctx context.Context, o Observer) context.Context {
+	return context.WithValue(ctx, observerKey{}, o)
+}
+
+// internal
+type nopObserver struct{}
+
+func (nopObserver) OnStageStart(Stage)                     {}
+func (nopObserver) OnStageEnd(Stage, time.Duration, error) {}
+
+func observerFrom(ctx context.Context) Observer {
+	if o, ok := ctx.Value(observerKey{}).(Observer); ok {
+		return o
+	}
+	return nopObserver{}
+}
+
+func observe[A, B any](o Observer, n int, f func(A) B) func(A) B {
+	if o == nil {
+		o = nopObserver{}
+	}
+
+	s := Stage{n, funcName(f)}
+	return func(a A) B {
+		start := time.Now()
+		o.OnStageStart(s)
+		completed := false
+		defer func() {
+			if !completed {
+				r := recover()
+				o.OnStageEnd(s, time.Since(start), &PanicError{r, debug.Stack()})
+				panic(r)
+			}
+		}()
+		b := f(a)
+		completed = true
+		o.OnStageEnd(s, time.Since(start), nil)
+		return b
+	}
+}
+
+func funcName(f any) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if fn == nil {
+		return ""
+	}
+	name := fn.Name()
+	return name[strings.LastIndex(name, "/")+1:]
+}
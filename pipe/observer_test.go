@@ -0,0 +1,99 @@
+package pipe
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestPipeObservedStages(t *testing.T) {
+	o := &recorder{}
+	result := PipeObserved2(o, double, amountAsDozenString)(3)
+
+	if expected := "half a dozen"; result != expected {
+		t.Errorf("expected %s, but got %s", expected, result)
+	}
+
+	tests := []struct {
+		expected Stage
+		result   Stage
+	}{
+		{Stage{1, "pipe.double"}, o.started[0]},
+		{Stage{2, "pipe.amountAsDozenString"}, o.started[1]},
+		{Stage{1, "pipe.double"}, o.ended[0]},
+		{Stage{2, "pipe.amountAsDozenString"}, o.ended[1]},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if tt.result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, tt.result)
+			}
+		})
+	}
+}
+
+func TestPipeObservedPanic(t *testing.T) {
+	o := &recorder{}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected the panic to be propagated")
+		}
+
+		var panicErr *PanicError
+		if len(o.errs) != 2 || !errors.As(o.errs[1], &panicErr) {
+			t.Errorf("expected the panic to be reported, but got %v", o.errs)
+		}
+	}()
+
+	PipeObserved2(o, double, func(int) int { panic("failure") })(1)
+}
+
+func TestPipeObservedPanicNil(t *testing.T) {
+	o := &recorder{}
+
+	defer func() {
+		recover()
+
+		var panicErr *PanicError
+		if len(o.errs) != 2 || !errors.As(o.errs[1], &panicErr) {
+			t.Errorf("expected the panic to be reported, but got %v", o.errs)
+		}
+	}()
+
+	PipeObserved2(o, double, func(int) int { panic(nil) })(1)
+	t.Errorf("expected the panic to be propagated")
+}
+
+func TestPipeObservedNil(t *testing.T) {
+	result := PipeObserved2(nil, double, amountAsDozenString)(3)
+
+	if expected := "half a dozen"; result != expected {
+		t.Errorf("expected %s, but got %s", expected, result)
+	}
+}
+
+func TestPipeCtxObserver(t *testing.T) {
+	o := &recorder{}
+	expected := errors.New("failure")
+
+	ctx := WithObserver(context.Background(), o)
+	_, err := PipeCtx3(
+		incrementCtx,
+		func(context.Context, int) (int, error) { return 0, expected },
+		incrementCtx,
+	)(ctx, 0)
+
+	if !errors.Is(err, expected) {
+		t.Errorf("expected %s, but got %v", expected, err)
+	}
+
+	if len(o.started) != 2 || len(o.ended) != 2 {
+		t.Fatalf("expected %d stages, but got %d", 2, len(o.ended))
+	}
+
+	if o.errs[0] != nil || o.errs[1] != expected {
+		t.Errorf("expected %v, but got %v", []error{nil, expected}, o.errs)
+	}
+}
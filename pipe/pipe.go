@@ -1,16 +1,9 @@
-// Provides `Pipe` function for function composition and chaining
-//
-// `Pipe` function composes functions in a sequence from left to right and
-// takes the initial data as the first argument when invoking the resulting
-// composed function, as demonstrated by `Pipe2(fn1, fn2)(initialdata)`
-//
-// To ensure type safety in go, it is necessary to define separate `Pipe`
-// functions for each specific number of arguments. for instance, if there are
-// three pipeable arguments, we would call `Pipe3(fn1, fn2, fn3)`.
+// Code generated by "go run ./internal/gen -n 12"; DO NOT EDIT.
+
 package pipe
 
-// Pipe takes one function as an argument and returns a function that takes
-// a value `a` and returns the output `b` of the given function
+// Pipe takes one function as an argument and returns a function that takes a
+// value `a` and returns the output `b` of the given function
 func Pipe[A, B any](
 	ab func(A) B,
 ) func(A) B {
@@ -143,8 +136,8 @@ func Pipe10[A, B, C, D, E, F, G, H, I, J, K any](
 	return func(a A) K { return jk(Pipe9(ab, bc, cd, de, ef, fg, gh, hi, ij)(a)) }
 }
 
-// Pipe11 takes ten functions as arguments and returns a function that takes a
-// value `a` and returns the output `l` obtained by applying the function
+// Pipe11 takes eleven functions as arguments and returns a function that takes
+// a value `a` and returns the output `l` obtained by applying the function
 // arguments in a sequence
 func Pipe11[A, B, C, D, E, F, G, H, I, J, K, L any](
 	ab func(A) B,
@@ -162,8 +155,8 @@ func Pipe11[A, B, C, D, E, F, G, H, I, J, K, L any](
 	return func(a A) L { return kl(Pipe10(ab, bc, cd, de, ef, fg, gh, hi, ij, jk)(a)) }
 }
 
-// Pipe12 takes ten functions as arguments and returns a function that takes a
-// value `a` and returns the output `m` obtained by applying the function
+// Pipe12 takes twelve functions as arguments and returns a function that takes
+// a value `a` and returns the output `m` obtained by applying the function
 // arguments in a sequence
 func Pipe12[A, B, C, D, E, F, G, H, I, J, K, L, M any](
 	ab func(A) B,
@@ -1,6 +1,8 @@
 package pipe
 
 import (
+	"context"
+	"errors"
 	"testing"
 )
 
@@ -52,3 +54,115 @@ func TestPipeChangeType(t *testing.T) {
 		t.Errorf("expected %s, but got %s", expected, result)
 	}
 }
+
+func TestComposeOrder(t *testing.T) {
+	expected := "half a dozen"
+	result := Compose2(
+		amountAsDozenString,
+		double,
+	)(3)
+
+	if result != expected {
+		t.Errorf("expected %s, but got %s", expected, result)
+	}
+}
+
+func TestFlow(t *testing.T) {
+	tests := []struct {
+		expected any
+		fns      []func(any) any
+	}{
+		{3, nil},
+		{6, []func(any) any{func(x any) any { return double(x.(int)) }}},
+		{"half a dozen", []func(any) any{
+			func(x any) any { return double(x.(int)) },
+			func(x any) any { return amountAsDozenString(x.(int)) },
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Flow(tt.fns...)(3)
+
+			if result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPipeCtxCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	called := false
+	_, err := PipeCtx3(
+		incrementCtx,
+		func(_ context.Context, x int) (int, error) {
+			cancel()
+			return x, nil
+		},
+		func(_ context.Context, x int) (int, error) {
+			called = true
+			return x, nil
+		},
+	)(ctx, 0)
+
+	if called {
+		t.Errorf("expected the pipe to stop after cancellation")
+	}
+
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected %s, but got %v", context.Canceled, err)
+	}
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %T", err)
+	}
+
+	if stageErr.Stage != 3 {
+		t.Errorf("expected %d, but got %d", 3, stageErr.Stage)
+	}
+}
+
+func TestPipeCtxPanicError(t *testing.T) {
+	expected := errors.New("failure")
+
+	_, err := PipeCtx(func(context.Context, int) (int, error) {
+		panic(expected)
+	})(context.Background(), 0)
+
+	if !errors.Is(err, expected) {
+		t.Errorf("expected %s, but got %v", expected, err)
+	}
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %T", err)
+	}
+
+	if len(panicErr.Stack) == 0 {
+		t.Errorf("expected a stack trace")
+	}
+}
+
+func TestPipeCtxPanicNil(t *testing.T) {
+	_, err := PipeCtx2(incrementCtx, func(context.Context, int) (int, error) {
+		panic(nil)
+	})(context.Background(), 0)
+
+	var panicErr *PanicError
+	if !errors.As(err, &panicErr) {
+		t.Fatalf("expected a *PanicError, but got %v", err)
+	}
+
+	var stageErr *StageError
+	if !errors.As(err, &stageErr) {
+		t.Fatalf("expected a *StageError, but got %T", err)
+	}
+
+	if stageErr.Stage != 2 {
+		t.Errorf("expected %d, but got %d", 2, stageErr.Stage)
+	}
+}
@@ -0,0 +1,290 @@
+// Code generated by "go run ./internal/gen -n 12"; DO NOT EDIT.
+
+package pipe
+
+import "context"
+
+// PipeCtx takes one context-aware function as an argument and returns a
+// function that takes a context and a value `a` and returns the output `b` of
+// the given function. A cancelled context or a panic is returned as a
+// *StageError
+func PipeCtx[A, B any](
+	ab func(context.Context, A) (B, error),
+) func(context.Context, A) (B, error) {
+	return stageCtx(1, ab)
+}
+
+// PipeCtx2 takes two context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `c`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx2[A, B, C any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+) func(context.Context, A) (C, error) {
+	prev, last := PipeCtx(ab), stageCtx(2, bc)
+	return func(ctx context.Context, a A) (C, error) {
+		b, err := prev(ctx, a)
+		if err != nil {
+			return *new(C), err
+		}
+		return last(ctx, b)
+	}
+}
+
+// PipeCtx3 takes three context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `d`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx3[A, B, C, D any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+) func(context.Context, A) (D, error) {
+	prev, last := PipeCtx2(ab, bc), stageCtx(3, cd)
+	return func(ctx context.Context, a A) (D, error) {
+		c, err := prev(ctx, a)
+		if err != nil {
+			return *new(D), err
+		}
+		return last(ctx, c)
+	}
+}
+
+// PipeCtx4 takes four context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `e`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx4[A, B, C, D, E any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+) func(context.Context, A) (E, error) {
+	prev, last := PipeCtx3(ab, bc, cd), stageCtx(4, de)
+	return func(ctx context.Context, a A) (E, error) {
+		d, err := prev(ctx, a)
+		if err != nil {
+			return *new(E), err
+		}
+		return last(ctx, d)
+	}
+}
+
+// PipeCtx5 takes five context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `f`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx5[A, B, C, D, E, F any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+	ef func(context.Context, E) (F, error),
+) func(context.Context, A) (F, error) {
+	prev, last := PipeCtx4(ab, bc, cd, de), stageCtx(5, ef)
+	return func(ctx context.Context, a A) (F, error) {
+		e, err := prev(ctx, a)
+		if err != nil {
+			return *new(F), err
+		}
+		return last(ctx, e)
+	}
+}
+
+// PipeCtx6 takes six context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `g`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx6[A, B, C, D, E, F, G any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+	ef func(context.Context, E) (F, error),
+	fg func(context.Context, F) (G, error),
+) func(context.Context, A) (G, error) {
+	prev, last := PipeCtx5(ab, bc, cd, de, ef), stageCtx(6, fg)
+	return func(ctx context.Context, a A) (G, error) {
+		f, err := prev(ctx, a)
+		if err != nil {
+			return *new(G), err
+		}
+		return last(ctx, f)
+	}
+}
+
+// PipeCtx7 takes seven context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `h`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx7[A, B, C, D, E, F, G, H any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+	ef func(context.Context, E) (F, error),
+	fg func(context.Context, F) (G, error),
+	gh func(context.Context, G) (H, error),
+) func(context.Context, A) (H, error) {
+	prev, last := PipeCtx6(ab, bc, cd, de, ef, fg), stageCtx(7, gh)
+	return func(ctx context.Context, a A) (H, error) {
+		g, err := prev(ctx, a)
+		if err != nil {
+			return *new(H), err
+		}
+		return last(ctx, g)
+	}
+}
+
+// PipeCtx8 takes eight context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `i`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx8[A, B, C, D, E, F, G, H, I any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+	ef func(context.Context, E) (F, error),
+	fg func(context.Context, F) (G, error),
+	gh func(context.Context, G) (H, error),
+	hi func(context.Context, H) (I, error),
+) func(context.Context, A) (I, error) {
+	prev, last := PipeCtx7(ab, bc, cd, de, ef, fg, gh), stageCtx(8, hi)
+	return func(ctx context.Context, a A) (I, error) {
+		h, err := prev(ctx, a)
+		if err != nil {
+			return *new(I), err
+		}
+		return last(ctx, h)
+	}
+}
+
+// PipeCtx9 takes nine context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `j`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx9[A, B, C, D, E, F, G, H, I, J any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+	ef func(context.Context, E) (F, error),
+	fg func(context.Context, F) (G, error),
+	gh func(context.Context, G) (H, error),
+	hi func(context.Context, H) (I, error),
+	ij func(context.Context, I) (J, error),
+) func(context.Context, A) (J, error) {
+	prev, last := PipeCtx8(ab, bc, cd, de, ef, fg, gh, hi), stageCtx(9, ij)
+	return func(ctx context.Context, a A) (J, error) {
+		i, err := prev(ctx, a)
+		if err != nil {
+			return *new(J), err
+		}
+		return last(ctx, i)
+	}
+}
+
+// PipeCtx10 takes ten context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `k`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx10[A, B, C, D, E, F, G, H, I, J, K any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+	ef func(context.Context, E) (F, error),
+	fg func(context.Context, F) (G, error),
+	gh func(context.Context, G) (H, error),
+	hi func(context.Context, H) (I, error),
+	ij func(context.Context, I) (J, error),
+	jk func(context.Context, J) (K, error),
+) func(context.Context, A) (K, error) {
+	prev, last := PipeCtx9(ab, bc, cd, de, ef, fg, gh, hi, ij), stageCtx(10, jk)
+	return func(ctx context.Context, a A) (K, error) {
+		j, err := prev(ctx, a)
+		if err != nil {
+			return *new(K), err
+		}
+		return last(ctx, j)
+	}
+}
+
+// PipeCtx11 takes eleven context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `l`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx11[A, B, C, D, E, F, G, H, I, J, K, L any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+	ef func(context.Context, E) (F, error),
+	fg func(context.Context, F) (G, error),
+	gh func(context.Context, G) (H, error),
+	hi func(context.Context, H) (I, error),
+	ij func(context.Context, I) (J, error),
+	jk func(context.Context, J) (K, error),
+	kl func(context.Context, K) (L, error),
+) func(context.Context, A) (L, error) {
+	prev, last := PipeCtx10(ab, bc, cd, de, ef, fg, gh, hi, ij, jk), stageCtx(11, kl)
+	return func(ctx context.Context, a A) (L, error) {
+		k, err := prev(ctx, a)
+		if err != nil {
+			return *new(L), err
+		}
+		return last(ctx, k)
+	}
+}
+
+// PipeCtx12 takes twelve context-aware functions as arguments and returns a
+// function that takes a context and a value `a` and returns the output `m`
+// obtained by applying the function arguments in a sequence. The context is
+// checked for cancellation before each function, and a panic is recovered into
+// a *PanicError. The sequence is short-circuited on the first error, which is
+// returned as a *StageError
+func PipeCtx12[A, B, C, D, E, F, G, H, I, J, K, L, M any](
+	ab func(context.Context, A) (B, error),
+	bc func(context.Context, B) (C, error),
+	cd func(context.Context, C) (D, error),
+	de func(context.Context, D) (E, error),
+	ef func(context.Context, E) (F, error),
+	fg func(context.Context, F) (G, error),
+	gh func(context.Context, G) (H, error),
+	hi func(context.Context, H) (I, error),
+	ij func(context.Context, I) (J, error),
+	jk func(context.Context, J) (K, error),
+	kl func(context.Context, K) (L, error),
+	lm func(context.Context, L) (M, error),
+) func(context.Context, A) (M, error) {
+	prev, last := PipeCtx11(ab, bc, cd, de, ef, fg, gh, hi, ij, jk, kl), stageCtx(12, lm)
+	return func(ctx context.Context, a A) (M, error) {
+		l, err := prev(ctx, a)
+		if err != nil {
+			return *new(M), err
+		}
+		return last(ctx, l)
+	}
+}
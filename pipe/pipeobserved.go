@@ -0,0 +1,212 @@
+// Code generated by "go run ./internal/gen -n 12"; DO NOT EDIT.
+
+package pipe
+
+// PipeObserved takes an observer and one function as arguments and returns a
+// function that takes a value `a` and returns the output `b` of the given
+// function. The observer is notified when the function starts and ends. A `nil`
+// observer is ignored
+func PipeObserved[A, B any](
+	o Observer,
+	ab func(A) B,
+) func(A) B {
+	return observe(o, 1, ab)
+}
+
+// PipeObserved2 takes an observer and two functions as arguments and returns a
+// function that takes a value `a` and returns the output `c` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved2[A, B, C any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+) func(A) C {
+	prev, last := PipeObserved(o, ab), observe(o, 2, bc)
+	return func(a A) C { return last(prev(a)) }
+}
+
+// PipeObserved3 takes an observer and three functions as arguments and returns
+// a function that takes a value `a` and returns the output `d` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved3[A, B, C, D any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+) func(A) D {
+	prev, last := PipeObserved2(o, ab, bc), observe(o, 3, cd)
+	return func(a A) D { return last(prev(a)) }
+}
+
+// PipeObserved4 takes an observer and four functions as arguments and returns a
+// function that takes a value `a` and returns the output `e` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved4[A, B, C, D, E any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+) func(A) E {
+	prev, last := PipeObserved3(o, ab, bc, cd), observe(o, 4, de)
+	return func(a A) E { return last(prev(a)) }
+}
+
+// PipeObserved5 takes an observer and five functions as arguments and returns a
+// function that takes a value `a` and returns the output `f` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved5[A, B, C, D, E, F any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+	ef func(E) F,
+) func(A) F {
+	prev, last := PipeObserved4(o, ab, bc, cd, de), observe(o, 5, ef)
+	return func(a A) F { return last(prev(a)) }
+}
+
+// PipeObserved6 takes an observer and six functions as arguments and returns a
+// function that takes a value `a` and returns the output `g` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved6[A, B, C, D, E, F, G any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+	ef func(E) F,
+	fg func(F) G,
+) func(A) G {
+	prev, last := PipeObserved5(o, ab, bc, cd, de, ef), observe(o, 6, fg)
+	return func(a A) G { return last(prev(a)) }
+}
+
+// PipeObserved7 takes an observer and seven functions as arguments and returns
+// a function that takes a value `a` and returns the output `h` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved7[A, B, C, D, E, F, G, H any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+	ef func(E) F,
+	fg func(F) G,
+	gh func(G) H,
+) func(A) H {
+	prev, last := PipeObserved6(o, ab, bc, cd, de, ef, fg), observe(o, 7, gh)
+	return func(a A) H { return last(prev(a)) }
+}
+
+// PipeObserved8 takes an observer and eight functions as arguments and returns
+// a function that takes a value `a` and returns the output `i` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved8[A, B, C, D, E, F, G, H, I any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+	ef func(E) F,
+	fg func(F) G,
+	gh func(G) H,
+	hi func(H) I,
+) func(A) I {
+	prev, last := PipeObserved7(o, ab, bc, cd, de, ef, fg, gh), observe(o, 8, hi)
+	return func(a A) I { return last(prev(a)) }
+}
+
+// PipeObserved9 takes an observer and nine functions as arguments and returns a
+// function that takes a value `a` and returns the output `j` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved9[A, B, C, D, E, F, G, H, I, J any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+	ef func(E) F,
+	fg func(F) G,
+	gh func(G) H,
+	hi func(H) I,
+	ij func(I) J,
+) func(A) J {
+	prev, last := PipeObserved8(o, ab, bc, cd, de, ef, fg, gh, hi), observe(o, 9, ij)
+	return func(a A) J { return last(prev(a)) }
+}
+
+// PipeObserved10 takes an observer and ten functions as arguments and returns a
+// function that takes a value `a` and returns the output `k` obtained by
+// applying the function arguments in a sequence. The observer is notified when
+// each function starts and ends. A `nil` observer is ignored
+func PipeObserved10[A, B, C, D, E, F, G, H, I, J, K any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+	ef func(E) F,
+	fg func(F) G,
+	gh func(G) H,
+	hi func(H) I,
+	ij func(I) J,
+	jk func(J) K,
+) func(A) K {
+	prev, last := PipeObserved9(o, ab, bc, cd, de, ef, fg, gh, hi, ij), observe(o, 10, jk)
+	return func(a A) K { return last(prev(a)) }
+}
+
+// PipeObserved11 takes an observer and eleven functions as arguments and
+// returns a function that takes a value `a` and returns the output `l` obtained
+// by applying the function arguments in a sequence. The observer is notified
+// when each function starts and ends. A `nil` observer is ignored
+func PipeObserved11[A, B, C, D, E, F, G, H, I, J, K, L any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+	ef func(E) F,
+	fg func(F) G,
+	gh func(G) H,
+	hi func(H) I,
+	ij func(I) J,
+	jk func(J) K,
+	kl func(K) L,
+) func(A) L {
+	prev, last := PipeObserved10(o, ab, bc, cd, de, ef, fg, gh, hi, ij, jk), observe(o, 11, kl)
+	return func(a A) L { return last(prev(a)) }
+}
+
+// PipeObserved12 takes an observer and twelve functions as arguments and
+// returns a function that takes a value `a` and returns the output `m` obtained
+// by applying the function arguments in a sequence. The observer is notified
+// when each function starts and ends. A `nil` observer is ignored
+func PipeObserved12[A, B, C, D, E, F, G, H, I, J, K, L, M any](
+	o Observer,
+	ab func(A) B,
+	bc func(B) C,
+	cd func(C) D,
+	de func(D) E,
+	ef func(E) F,
+	fg func(F) G,
+	gh func(G) H,
+	hi func(H) I,
+	ij func(I) J,
+	jk func(J) K,
+	kl func(K) L,
+	lm func(L) M,
+) func(A) M {
+	prev, last := PipeObserved11(o, ab, bc, cd, de, ef, fg, gh, hi, ij, jk, kl), observe(o, 12, lm)
+	return func(a A) M { return last(prev(a)) }
+}
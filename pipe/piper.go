@@ -0,0 +1,254 @@
+// Code generated by "go run ./internal/gen -n 12"; DO NOT EDIT.
+
+package pipe
+
+// PipeR takes one function that can fail as an argument and returns a function
+// that takes a value `a` and returns the output `b` of the given function or a
+// *StageError
+func PipeR[A, B any](
+	ab func(A) (B, error),
+) func(A) (B, error) {
+	return stage(1, ab)
+}
+
+// PipeR2 takes two functions that can fail as arguments and returns a function
+// that takes a value `a` and returns the output `c` obtained by applying the
+// function arguments in a sequence. The sequence is short-circuited on the
+// first error, which is returned as a *StageError
+func PipeR2[A, B, C any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+) func(A) (C, error) {
+	return func(a A) (C, error) {
+		b, err := PipeR(ab)(a)
+		if err != nil {
+			return *new(C), err
+		}
+		return stage(2, bc)(b)
+	}
+}
+
+// PipeR3 takes three functions that can fail as arguments and returns a
+// function that takes a value `a` and returns the output `d` obtained by
+// applying the function arguments in a sequence. The sequence is
+// short-circuited on the first error, which is returned as a *StageError
+func PipeR3[A, B, C, D any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+) func(A) (D, error) {
+	return func(a A) (D, error) {
+		c, err := PipeR2(ab, bc)(a)
+		if err != nil {
+			return *new(D), err
+		}
+		return stage(3, cd)(c)
+	}
+}
+
+// PipeR4 takes four functions that can fail as arguments and returns a function
+// that takes a value `a` and returns the output `e` obtained by applying the
+// function arguments in a sequence. The sequence is short-circuited on the
+// first error, which is returned as a *StageError
+func PipeR4[A, B, C, D, E any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+) func(A) (E, error) {
+	return func(a A) (E, error) {
+		d, err := PipeR3(ab, bc, cd)(a)
+		if err != nil {
+			return *new(E), err
+		}
+		return stage(4, de)(d)
+	}
+}
+
+// PipeR5 takes five functions that can fail as arguments and returns a function
+// that takes a value `a` and returns the output `f` obtained by applying the
+// function arguments in a sequence. The sequence is short-circuited on the
+// first error, which is returned as a *StageError
+func PipeR5[A, B, C, D, E, F any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+	ef func(E) (F, error),
+) func(A) (F, error) {
+	return func(a A) (F, error) {
+		e, err := PipeR4(ab, bc, cd, de)(a)
+		if err != nil {
+			return *new(F), err
+		}
+		return stage(5, ef)(e)
+	}
+}
+
+// PipeR6 takes six functions that can fail as arguments and returns a function
+// that takes a value `a` and returns the output `g` obtained by applying the
+// function arguments in a sequence. The sequence is short-circuited on the
+// first error, which is returned as a *StageError
+func PipeR6[A, B, C, D, E, F, G any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+	ef func(E) (F, error),
+	fg func(F) (G, error),
+) func(A) (G, error) {
+	return func(a A) (G, error) {
+		f, err := PipeR5(ab, bc, cd, de, ef)(a)
+		if err != nil {
+			return *new(G), err
+		}
+		return stage(6, fg)(f)
+	}
+}
+
+// PipeR7 takes seven functions that can fail as arguments and returns a
+// function that takes a value `a` and returns the output `h` obtained by
+// applying the function arguments in a sequence. The sequence is
+// short-circuited on the first error, which is returned as a *StageError
+func PipeR7[A, B, C, D, E, F, G, H any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+	ef func(E) (F, error),
+	fg func(F) (G, error),
+	gh func(G) (H, error),
+) func(A) (H, error) {
+	return func(a A) (H, error) {
+		g, err := PipeR6(ab, bc, cd, de, ef, fg)(a)
+		if err != nil {
+			return *new(H), err
+		}
+		return stage(7, gh)(g)
+	}
+}
+
+// PipeR8 takes eight functions that can fail as arguments and returns a
+// function that takes a value `a` and returns the output `i` obtained by
+// applying the function arguments in a sequence. The sequence is
+// short-circuited on the first error, which is returned as a *StageError
+func PipeR8[A, B, C, D, E, F, G, H, I any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+	ef func(E) (F, error),
+	fg func(F) (G, error),
+	gh func(G) (H, error),
+	hi func(H) (I, error),
+) func(A) (I, error) {
+	return func(a A) (I, error) {
+		h, err := PipeR7(ab, bc, cd, de, ef, fg, gh)(a)
+		if err != nil {
+			return *new(I), err
+		}
+		return stage(8, hi)(h)
+	}
+}
+
+// PipeR9 takes nine functions that can fail as arguments and returns a function
+// that takes a value `a` and returns the output `j` obtained by applying the
+// function arguments in a sequence. The sequence is short-circuited on the
+// first error, which is returned as a *StageError
+func PipeR9[A, B, C, D, E, F, G, H, I, J any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+	ef func(E) (F, error),
+	fg func(F) (G, error),
+	gh func(G) (H, error),
+	hi func(H) (I, error),
+	ij func(I) (J, error),
+) func(A) (J, error) {
+	return func(a A) (J, error) {
+		i, err := PipeR8(ab, bc, cd, de, ef, fg, gh, hi)(a)
+		if err != nil {
+			return *new(J), err
+		}
+		return stage(9, ij)(i)
+	}
+}
+
+// PipeR10 takes ten functions that can fail as arguments and returns a function
+// that takes a value `a` and returns the output `k` obtained by applying the
+// function arguments in a sequence. The sequence is short-circuited on the
+// first error, which is returned as a *StageError
+func PipeR10[A, B, C, D, E, F, G, H, I, J, K any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+	ef func(E) (F, error),
+	fg func(F) (G, error),
+	gh func(G) (H, error),
+	hi func(H) (I, error),
+	ij func(I) (J, error),
+	jk func(J) (K, error),
+) func(A) (K, error) {
+	return func(a A) (K, error) {
+		j, err := PipeR9(ab, bc, cd, de, ef, fg, gh, hi, ij)(a)
+		if err != nil {
+			return *new(K), err
+		}
+		return stage(10, jk)(j)
+	}
+}
+
+// PipeR11 takes eleven functions that can fail as arguments and returns a
+// function that takes a value `a` and returns the output `l` obtained by
+// applying the function arguments in a sequence. The sequence is
+// short-circuited on the first error, which is returned as a *StageError
+func PipeR11[A, B, C, D, E, F, G, H, I, J, K, L any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+	ef func(E) (F, error),
+	fg func(F) (G, error),
+	gh func(G) (H, error),
+	hi func(H) (I, error),
+	ij func(I) (J, error),
+	jk func(J) (K, error),
+	kl func(K) (L, error),
+) func(A) (L, error) {
+	return func(a A) (L, error) {
+		k, err := PipeR10(ab, bc, cd, de, ef, fg, gh, hi, ij, jk)(a)
+		if err != nil {
+			return *new(L), err
+		}
+		return stage(11, kl)(k)
+	}
+}
+
+// PipeR12 takes twelve functions that can fail as arguments and returns a
+// function that takes a value `a` and returns the output `m` obtained by
+// applying the function arguments in a sequence. The sequence is
+// short-circuited on the first error, which is returned as a *StageError
+func PipeR12[A, B, C, D, E, F, G, H, I, J, K, L, M any](
+	ab func(A) (B, error),
+	bc func(B) (C, error),
+	cd func(C) (D, error),
+	de func(D) (E, error),
+	ef func(E) (F, error),
+	fg func(F) (G, error),
+	gh func(G) (H, error),
+	hi func(H) (I, error),
+	ij func(I) (J, error),
+	jk func(J) (K, error),
+	kl func(K) (L, error),
+	lm func(L) (M, error),
+) func(A) (M, error) {
+	return func(a A) (M, error) {
+		l, err := PipeR11(ab, bc, cd, de, ef, fg, gh, hi, ij, jk, kl)(a)
+		if err != nil {
+			return *new(M), err
+		}
+		return stage(12, lm)(l)
+	}
+}
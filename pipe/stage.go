@@ -0,0 +1,90 @@
+package pipe
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"time"
+)
+
+// StageError is returned by the error-aware `PipeR` and `PipeCtx` functions
+// when one of the function arguments fails. Stage is the position of the
+// failed function argument starting from one, and Err is the error returned by
+// it
+type StageError struct {
+	Stage int
+	Err   error
+}
+
+// Error implements the error interface
+func (e *StageError) Error() string {
+	return fmt.Sprintf("pipe stage %d: %s", e.Stage, e.Err)
+}
+
+// Unwrap returns the error returned by the failed function argument
+func (e *StageError) Unwrap() error {
+	return e.Err
+}
+
+// internal
+func stage[A, B any](n int, f func(A) (B, error)) func(A) (B, error) {
+	return func(a A) (B, error) {
+		b, err := f(a)
+		if err != nil {
+			return *new(B), &StageError{n, err}
+		}
+		return b, nil
+	}
+}
+
+// PanicError is returned by the context-aware `PipeCtx` functions when one of
+// the function arguments panics, and reported to the Observer of an observed
+// pipe. Value is the recovered panic value and Stack is the stack trace of the
+// goroutine at the time of the panic
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error implements the error interface
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the recovered panic value if it is an error
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// internal
+func stageCtx[A, B any](n int, f func(context.Context, A) (B, error)) func(context.Context, A) (B, error) {
+	s := Stage{n, funcName(f)}
+	return func(ctx context.Context, a A) (b B, err error) {
+		if err := ctx.Err(); err != nil {
+			return b, &StageError{n, err}
+		}
+
+		o, start := observerFrom(ctx), time.Now()
+		o.OnStageStart(s)
+
+		// The completed flag detects a panic also when the panic value is
+		// `nil`, in which case recover returns `nil`
+		completed := false
+		defer func() {
+			if !completed {
+				err = &PanicError{recover(), debug.Stack()}
+			}
+			o.OnStageEnd(s, time.Since(start), err)
+			if err != nil {
+				b, err = *new(B), &StageError{n, err}
+			}
+		}()
+
+		b, err = f(ctx, a)
+		completed = true
+		return b, err
+	}
+}
@@ -0,0 +1,135 @@
+// Reader monad represents computations that read values from a shared
+// environment. The environment is given once, when the computation is run, and
+// passed implicitly to every step of the computation.
+//
+// Reader monad provides a way to do dependency injection in a purely
+// functional manner. Configuration, loggers and database handles can be
+// accessed from the environment, instead of passing them explicitly through
+// every function in a composition.
+//
+// ReaderResult combines Reader monad with Result monad enabling computations
+// that read from the environment and can potentially fail.
+package reader
+
+import "github.com/erikjuhani/go-fp/result"
+
+// Reader represents the reader monad type, which is a computation that reads a
+// value from environment `r` and returns a value `a`
+type Reader[R, A any] func(R) A
+
+// ReaderResult represents the reader monad type combined with Result monad,
+// which is a computation that reads a value from environment `r` and returns
+// either a value `a` or an error
+type ReaderResult[R, A any] func(R) result.Result[A]
+
+// Of is the return operation for Reader monad that returns the given value `a`
+// ignoring the environment
+func Of[R, A any](val A) Reader[R, A] {
+	return func(R) A { return val }
+}
+
+// Ask retrieves the environment and sets it as the result
+func Ask[R any]() Reader[R, R] {
+	return func(r R) R { return r }
+}
+
+// Asks provides a way to access a specific component of the environment with
+// the given function `f`
+func Asks[R, A any](f func(R) A) Reader[R, A] {
+	return func(r R) A { return f(r) }
+}
+
+// Local runs the Reader monad in a modified environment. The function `f`
+// transforms the environment for the given Reader monad only
+func Local[A, R any](f func(R) R) func(Reader[R, A]) Reader[R, A] {
+	return func(m Reader[R, A]) Reader[R, A] {
+		return func(r R) A { return m(f(r)) }
+	}
+}
+
+// Run runs the Reader monad with the given environment `r` and returns the
+// computed result
+func Run[A, R any](r R) func(Reader[R, A]) A {
+	return func(m Reader[R, A]) A {
+		return m(r)
+	}
+}
+
+// Map function takes the contents of the Reader monad and passes it to
+// function `f` as a parameter. The function `f` returns a new Reader monad as
+// the result
+func Map[R, A, B any](f func(A) B) func(Reader[R, A]) Reader[R, B] {
+	return func(m Reader[R, A]) Reader[R, B] {
+		return func(r R) B { return f(m(r)) }
+	}
+}
+
+// Fmap or also known as `bind` function lets non-monadic function `f` to
+// operate on the contents of monad m a, and lifts the value to a new domain
+// (Reader a -> Reader b).
+func Fmap[A, B, R any](f func(A) Reader[R, B]) func(Reader[R, A]) Reader[R, B] {
+	return func(m Reader[R, A]) Reader[R, B] {
+		return func(r R) B { return f(m(r))(r) }
+	}
+}
+
+// Ok is the return operation for ReaderResult monad that returns the
+// representation of successful operation
+func Ok[R, A any](val A) ReaderResult[R, A] {
+	return func(R) result.Result[A] { return result.Ok(val) }
+}
+
+// Err is the return operation for ReaderResult monad that returns the
+// representation of failing operation
+func Err[R, A any](err error) ReaderResult[R, A] {
+	return func(R) result.Result[A] { return result.Err[A](err) }
+}
+
+// Lift lifts the Reader monad into ReaderResult monad that always succeeds
+func Lift[R, A any](m Reader[R, A]) ReaderResult[R, A] {
+	return func(r R) result.Result[A] { return result.Ok(m(r)) }
+}
+
+// AsksResult provides a way to access a specific component of the environment
+// with the given function `f` that can potentially fail
+func AsksResult[R, A any](f func(R) result.Result[A]) ReaderResult[R, A] {
+	return func(r R) result.Result[A] { return f(r) }
+}
+
+// LocalResult runs the ReaderResult monad in a modified environment. The
+// function `f` transforms the environment for the given ReaderResult monad
+// only
+func LocalResult[A, R any](f func(R) R) func(ReaderResult[R, A]) ReaderResult[R, A] {
+	return func(m ReaderResult[R, A]) ReaderResult[R, A] {
+		return func(r R) result.Result[A] { return m(f(r)) }
+	}
+}
+
+// RunResult runs the ReaderResult monad with the given environment `r` and
+// returns the computed Result monad
+func RunResult[A, R any](r R) func(ReaderResult[R, A]) result.Result[A] {
+	return func(m ReaderResult[R, A]) result.Result[A] {
+		return m(r)
+	}
+}
+
+// MapResult function takes the successful contents of the ReaderResult monad
+// and passes it to function `f` as a parameter. The function `f` returns a new
+// ReaderResult monad as the result. The error is propagated as is
+func MapResult[R, A, B any](f func(A) B) func(ReaderResult[R, A]) ReaderResult[R, B] {
+	return func(m ReaderResult[R, A]) ReaderResult[R, B] {
+		return func(r R) result.Result[B] { return result.Map(f)(m(r)) }
+	}
+}
+
+// FmapResult or also known as `bind` function lets non-monadic function `f` to
+// operate on the successful contents of monad m a, and lifts the value to a
+// new domain (ReaderResult a -> ReaderResult b). The function `f` is not
+// called if the ReaderResult monad contains an error
+func FmapResult[A, B, R any](f func(A) ReaderResult[R, B]) func(ReaderResult[R, A]) ReaderResult[R, B] {
+	return func(m ReaderResult[R, A]) ReaderResult[R, B] {
+		return func(r R) result.Result[B] {
+			return result.Fmap(func(a A) result.Result[B] { return f(a)(r) })(m(r))
+		}
+	}
+}
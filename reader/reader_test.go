@@ -0,0 +1,148 @@
+package reader
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+	"github.com/erikjuhani/go-fp/result"
+)
+
+type config struct {
+	greeting string
+	users    map[int]string
+}
+
+var env = config{
+	greeting: "hello",
+	users:    map[int]string{1: "john"},
+}
+
+func greet(name string) Reader[config, string] {
+	return Asks(func(c config) string { return c.greeting + " " + name })
+}
+
+func findUser(id int) ReaderResult[config, string] {
+	return AsksResult(func(c config) result.Result[string] {
+		if name, ok := c.users[id]; ok {
+			return result.Ok(name)
+		}
+		return result.Err[string](errors.New("user not found"))
+	})
+}
+
+func TestAsk(t *testing.T) {
+	result := pipe.Pipe2(
+		Map[config](func(c config) string { return c.greeting }),
+		Run[string](env),
+	)(Ask[config]())
+
+	if result != env.greeting {
+		t.Errorf("expected %s, but got %s", env.greeting, result)
+	}
+}
+
+func TestFmap(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     string
+	}{
+		{"hello john", "john"},
+		{"hello jane", "jane"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				Fmap(greet),
+				Run[string](env),
+			)(Of[config](tt.data))
+
+			if result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestLocal(t *testing.T) {
+	result := pipe.Pipe3(
+		greet,
+		Local[string](func(c config) config {
+			c.greeting = "hi"
+			return c
+		}),
+		Run[string](env),
+	)("john")
+
+	if expected := "hi john"; result != expected {
+		t.Errorf("expected %s, but got %s", expected, result)
+	}
+}
+
+func TestFmapResult(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     int
+	}{
+		{"user not found", 2},
+		{"HELLO JOHN", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			res := pipe.Pipe5(
+				findUser,
+				FmapResult(func(name string) ReaderResult[config, string] { return Lift(greet(name)) }),
+				MapResult[config](strings.ToUpper),
+				RunResult[string](env),
+				result.Match(
+					func(err error) string { return err.Error() },
+					func(val string) string { return val },
+				),
+			)(tt.data)
+
+			if res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestLocalResult(t *testing.T) {
+	res := pipe.Pipe3(
+		LocalResult[string](func(c config) config {
+			return config{users: map[int]string{1: "jane"}}
+		}),
+		RunResult[string](env),
+		result.Match(
+			func(err error) string { return err.Error() },
+			func(val string) string { return val },
+		),
+	)(findUser(1))
+
+	if expected := "jane"; res != expected {
+		t.Errorf("expected %s, but got %s", expected, res)
+	}
+}
+
+func TestErr(t *testing.T) {
+	res := pipe.Pipe2(
+		FmapResult(findUser),
+		RunResult[string](env),
+	)(Err[config, int](errors.New("failure")))
+
+	if result.IsOk(res) {
+		t.Errorf("expected an error, but got ok")
+	}
+
+	res = pipe.Pipe2(
+		FmapResult(findUser),
+		RunResult[string](env),
+	)(Ok[config](1))
+
+	if result.Unwrap(res) != "john" {
+		t.Errorf("expected %s, but got %s", "john", result.Unwrap(res))
+	}
+}
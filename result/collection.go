@@ -0,0 +1,65 @@
+package result
+
+import "errors"
+
+// Sequence turns a slice of Result monads into a Result monad of a slice. The
+// result is Ok only if all Result monads are Ok, otherwise the first error is
+// returned
+func Sequence[A any](ms []Result[A]) Result[[]A] {
+	vals := make([]A, 0, len(ms))
+	for _, m := range ms {
+		if IsErr(m) {
+			return Result[[]A]{err: m.err}
+		}
+		vals = append(vals, m.val)
+	}
+	return Ok(vals)
+}
+
+// Traverse applies function `f` to each element of the slice and returns the
+// results as a Result monad of a slice. The traversal is stopped on the first
+// error, which is returned
+func Traverse[A, B any](f func(A) Result[B]) func([]A) Result[[]B] {
+	return func(as []A) Result[[]B] {
+		vals := make([]B, 0, len(as))
+		for _, a := range as {
+			m := f(a)
+			if IsErr(m) {
+				return Result[[]B]{err: m.err}
+			}
+			vals = append(vals, m.val)
+		}
+		return Ok(vals)
+	}
+}
+
+// Partition splits a slice of Result monads into the successful values and the
+// errors as a tuple `(oks, errs)`, preserving the order of both
+func Partition[A any](ms []Result[A]) ([]A, []error) {
+	var (
+		oks  []A
+		errs []error
+	)
+	for _, m := range ms {
+		if IsErr(m) {
+			errs = append(errs, m.err)
+			continue
+		}
+		oks = append(oks, m.val)
+	}
+	return oks, errs
+}
+
+// CollectAll turns a slice of Result monads into a Result monad of a slice.
+// Unlike Sequence, CollectAll does not stop on the first error, but joins all
+// the errors into a single error with errors.Join
+func CollectAll[A any](ms []Result[A]) Result[[]A] {
+	oks, errs := Partition(ms)
+	if len(errs) > 0 {
+		return Err[[]A](errors.Join(errs...))
+	}
+	if oks == nil {
+		oks = []A{}
+	}
+	return Ok(oks)
+}
@@ -0,0 +1,91 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func show[A any](m Result[A]) string {
+	return Match(
+		func(err error) string { return err.Error() },
+		func(val A) string { return fmt.Sprint(val) },
+	)(m)
+}
+
+func TestSequence(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []Result[int]
+	}{
+		{"[]", nil},
+		{"[1 2]", []Result[int]{Ok(1), Ok(2)}},
+		{"first", []Result[int]{Ok(1), Err[int](errors.New("first")), Err[int](errors.New("second"))}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(Sequence(tt.data)); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []string
+	}{
+		{"[]", []string{}},
+		{"[1 2]", []string{"1", "2"}},
+		{`strconv.Atoi: parsing "x": invalid syntax`, []string{"1", "x", "y"}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Traverse(func(s string) Result[int] { return From(strconv.Atoi(s)) })(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestPartition(t *testing.T) {
+	oks, errs := Partition([]Result[int]{
+		Ok(1),
+		Err[int](errors.New("first")),
+		Ok(2),
+		Err[int](errors.New("second")),
+	})
+
+	if result := fmt.Sprint(oks); result != "[1 2]" {
+		t.Errorf("expected %s, but got %s", "[1 2]", result)
+	}
+
+	if result := errors.Join(errs...).Error(); result != "first\nsecond" {
+		t.Errorf("expected %s, but got %s", "first\nsecond", result)
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []Result[int]
+	}{
+		{"[]", nil},
+		{"[1 2]", []Result[int]{Ok(1), Ok(2)}},
+		{"first\nsecond", []Result[int]{Ok(1), Err[int](errors.New("first")), Err[int](errors.New("second"))}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(CollectAll(tt.data)); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
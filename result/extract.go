@@ -0,0 +1,59 @@
+package result
+
+import "fmt"
+
+// UnwrapOr gets the success value of the Result monad and defaults to the
+// value `val` if the Result monad contains an error
+func UnwrapOr[A any](val A) func(Result[A]) A {
+	return func(m Result[A]) A {
+		if IsErr(m) {
+			return val
+		}
+		return m.val
+	}
+}
+
+// UnwrapOrElse gets the success value of the Result monad and defaults to the
+// value returned by function `f` if the Result monad contains an error. The
+// function `f` is called only when the Result monad contains an error
+func UnwrapOrElse[A any](f func(error) A) func(Result[A]) A {
+	return func(m Result[A]) A {
+		if IsErr(m) {
+			return f(m.err)
+		}
+		return m.val
+	}
+}
+
+// Expect gets the success value of the Result monad and panics if the Result
+// monad contains an error. The panic value is an error that wraps the
+// contained error with the given message `msg`. Expect is intended for errors
+// that are considered to be programming errors
+func Expect[A any](msg string) func(Result[A]) A {
+	return func(m Result[A]) A {
+		if IsErr(m) {
+			panic(fmt.Errorf("%s: %w", msg, m.err))
+		}
+		return m.val
+	}
+}
+
+// Get returns the contents of the Result monad as a tuple `(T, error)`. Get is
+// the inverse of From and is intended to be used when handing the value back
+// to Go code
+func Get[A any](m Result[A]) (A, error) {
+	if IsErr(m) {
+		var zero A
+		return zero, m.err
+	}
+	return m.val, nil
+}
+
+// Flatten removes one level of nesting from the Result monad. The error of
+// either the outer or the inner Result monad is propagated as is
+func Flatten[A any](m Result[Result[A]]) Result[A] {
+	if IsErr(m) {
+		return Result[A]{err: m.err}
+	}
+	return m.val
+}
@@ -0,0 +1,102 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+func TestUnwrapOr(t *testing.T) {
+	tests := []struct {
+		expected int
+		calls    int
+		data     []int
+	}{
+		{-1, 1, []int{}},
+		{1, 0, []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := pipe.Pipe2(head[int], UnwrapOr(-1))(tt.data); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+
+			calls := 0
+			result := pipe.Pipe2(head[int], UnwrapOrElse(func(error) int {
+				calls++
+				return -1
+			}))(tt.data)
+			if result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+			if calls != tt.calls {
+				t.Errorf("expected %v calls, but got %v", tt.calls, calls)
+			}
+		})
+	}
+}
+
+func TestExpect(t *testing.T) {
+	if result := Expect[int]("head")(Ok(1)); result != 1 {
+		t.Errorf("expected %v, but got %v", 1, result)
+	}
+
+	errEmpty := errors.New("empty")
+	defer func() {
+		err, ok := recover().(error)
+		if !ok {
+			t.Fatalf("expected panic with an error")
+		}
+		if expected := "head: empty"; err.Error() != expected {
+			t.Errorf("expected %v, but got %v", expected, err)
+		}
+		if !errors.Is(err, errEmpty) {
+			t.Errorf("expected %v to wrap %v", err, errEmpty)
+		}
+	}()
+
+	Expect[int]("head")(Err[int](errEmpty))
+}
+
+func TestGet(t *testing.T) {
+	errEmpty := errors.New("empty")
+
+	tests := []struct {
+		expected    int
+		expectedErr error
+		data        Result[int]
+	}{
+		{1, nil, Ok(1)},
+		{0, errEmpty, Err[int](errEmpty)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			val, err := Get(tt.data)
+			if val != tt.expected || err != tt.expectedErr {
+				t.Errorf("expected (%v, %v), but got (%v, %v)", tt.expected, tt.expectedErr, val, err)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Result[Result[int]]
+	}{
+		{"1", Ok(Ok(1))},
+		{"inner", Ok(Err[int](errors.New("inner")))},
+		{"outer", Err[Result[int]](errors.New("outer"))},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(Flatten(tt.data)); result != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package result
+
+import "github.com/erikjuhani/go-fp/tuple"
+
+// Void represents an unit or void that is present in an operation that does
+// not produce a result. The Void types of the state and writer packages are
+// aliases of this type, so the unit value is interchangeable between them
+type Void struct{}
+
+// Lift lifts a Go function `f` returning a tuple `(B, error)` into a function
+// returning a Result monad. The lifted function can be used directly as a
+// stage in pipe.Pipe functions
+//
+//	atoi := result.Lift(strconv.Atoi)
+//	atoi("1") // -> Ok 1
+func Lift[A, B any](f func(A) (B, error)) func(A) Result[B] {
+	return func(a A) Result[B] {
+		val, err := f(a)
+		if err != nil {
+			return errAt[B](err, 1)
+		}
+		return Ok(val)
+	}
+}
+
+// Lift2 lifts a Go function `f` of two arguments returning a tuple
+// `(C, error)` into a function returning a Result monad
+func Lift2[A, B, C any](f func(A, B) (C, error)) func(A, B) Result[C] {
+	return func(a A, b B) Result[C] {
+		val, err := f(a, b)
+		if err != nil {
+			return errAt[C](err, 1)
+		}
+		return Ok(val)
+	}
+}
+
+// Lift3 lifts a Go function `f` of three arguments returning a tuple
+// `(D, error)` into a function returning a Result monad
+func Lift3[A, B, C, D any](f func(A, B, C) (D, error)) func(A, B, C) Result[D] {
+	return func(a A, b B, c C) Result[D] {
+		val, err := f(a, b, c)
+		if err != nil {
+			return errAt[D](err, 1)
+		}
+		return Ok(val)
+	}
+}
+
+// LiftErr lifts a Go function `f` returning only an error into a function
+// returning a Result monad. The successful value is Void as the function does
+// not produce a result
+func LiftErr[A any](f func(A) error) func(A) Result[Void] {
+	return func(a A) Result[Void] {
+		if err := f(a); err != nil {
+			return errAt[Void](err, 1)
+		}
+		return Ok(Void{})
+	}
+}
+
+// From2 is the return operation for Result monad for Go functions that return
+// two values and an error as `(A, B, error)`. The values are returned as a
+// tuple.Pair
+//
+//	result.From2(net.SplitHostPort("localhost:80")) // -> Ok (localhost, 80)
+func From2[A, B any](a A, b B, err error) Result[tuple.Pair[A, B]] {
+	if err != nil {
+		return errAt[tuple.Pair[A, B]](err, 1)
+	}
+	return Ok(tuple.NewPair(a, b))
+}
+
+// From3 is the return operation for Result monad for Go functions that return
+// three values and an error as `(A, B, C, error)`. The values are returned as
+// a tuple.Triple
+func From3[A, B, C any](a A, b B, c C, err error) Result[tuple.Triple[A, B, C]] {
+	if err != nil {
+		return errAt[tuple.Triple[A, B, C]](err, 1)
+	}
+	return Ok(tuple.NewTriple(a, b, c))
+}
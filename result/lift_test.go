@@ -0,0 +1,158 @@
+package result
+
+import (
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+	"github.com/erikjuhani/go-fp/tuple"
+)
+
+func TestLift(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     string
+	}{
+		{"0.5", "2"},
+		{"division by zero", "0"},
+		{`strconv.Atoi: parsing "a": invalid syntax`, "a"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				Lift(strconv.Atoi),
+				Fmap(inverse),
+			)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestLift2(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     string
+		base     int
+	}{
+		{"255", "ff", 16},
+		{`strconv.ParseInt: parsing "ff": invalid syntax`, "ff", 10},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			parse := Lift2(func(s string, base int) (int64, error) {
+				return strconv.ParseInt(s, base, 64)
+			})
+
+			if res := show(parse(tt.data, tt.base)); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestLift3(t *testing.T) {
+	parse := Lift3(strconv.ParseInt)
+
+	tests := []struct {
+		expected string
+		data     string
+		bits     int
+	}{
+		{"127", "127", 8},
+		{`strconv.ParseInt: parsing "128": value out of range`, "128", 8},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if res := show(parse(tt.data, 10, tt.bits)); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestLiftErr(t *testing.T) {
+	errNegative := errors.New("negative")
+	validate := LiftErr(func(x int) error {
+		if x < 0 {
+			return errNegative
+		}
+		return nil
+	})
+
+	tests := []struct {
+		expected string
+		data     int
+	}{
+		{"{}", 1},
+		{"negative", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if res := show(validate(tt.data)); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestFrom2(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     string
+	}{
+		{"(localhost, 80)", "localhost:80"},
+		{"address localhost: missing port in address", "localhost"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if res := show(From2(net.SplitHostPort(tt.data))); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestFrom3(t *testing.T) {
+	errParse := errors.New("parse error")
+
+	tests := []struct {
+		expected string
+		data     Result[tuple.Triple[int, string, bool]]
+	}{
+		{"(1, a, true)", From3(1, "a", true, nil)},
+		{"parse error", From3(0, "", false, errParse)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if res := show(tt.data); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestLiftTrace(t *testing.T) {
+	SetTrace(true)
+	defer SetTrace(false)
+
+	var traced *TracedError
+	if err := Lift(strconv.Atoi)("a").err; !errors.As(err, &traced) {
+		t.Fatalf("expected a traced error, but got %v", err)
+	}
+
+	if frames := traced.Frames(); len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "TestLiftTrace") {
+		t.Errorf("expected the origin to be TestLiftTrace, but got %v", frames)
+	}
+}
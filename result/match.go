@@ -0,0 +1,50 @@
+package result
+
+import "errors"
+
+// Handler handles an error and returns the value determined by the return type
+// of b. Handler reports whether it handled the error. Handlers are created with
+// On and OnIs, and used with MatchErr
+type Handler[B any] func(err error) (B, bool)
+
+// On returns a Handler that handles errors matching the error type `E` using
+// errors.As. The matched error is passed to function `f` as its concrete type
+func On[E error, B any](f func(E) B) Handler[B] {
+	return func(err error) (B, bool) {
+		var target E
+		if errors.As(err, &target) {
+			return f(target), true
+		}
+		return *new(B), false
+	}
+}
+
+// OnIs returns a Handler that handles errors matching the `target` error using
+// errors.Is, which is useful for sentinel errors
+func OnIs[B any](target error, f func(error) B) Handler[B] {
+	return func(err error) (B, bool) {
+		if errors.Is(err, target) {
+			return f(err), true
+		}
+		return *new(B), false
+	}
+}
+
+// MatchErr matches Result monad depending of it's current state like Match,
+// but instead of a single error function, the error is matched against the
+// given handlers in order. The value of the first handler that handles the
+// error is returned. If none of the handlers handle the error, the `Otherwise`
+// function is called. Wrapped and joined errors are matched as well
+func MatchErr[A, B any](Ok func(val A) B, Otherwise func(err error) B, handlers ...Handler[B]) func(Result[A]) B {
+	return func(m Result[A]) B {
+		if IsOk(m) {
+			return Ok(m.val)
+		}
+		for _, h := range handlers {
+			if b, ok := h(m.err); ok {
+				return b
+			}
+		}
+		return Otherwise(m.err)
+	}
+}
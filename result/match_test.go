@@ -0,0 +1,49 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+type validationError struct{ field string }
+
+func (e *validationError) Error() string { return "invalid " + e.field }
+
+type statusError struct{ code int }
+
+func (e statusError) Error() string { return fmt.Sprintf("status %d", e.code) }
+
+var errTimeout = errors.New("timeout")
+
+func TestMatchErr(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Result[string]
+	}{
+		{"ok success", Ok("success")},
+		{"validation name", Err[string](&validationError{"name"})},
+		{"status 404", Err[string](statusError{404})},
+		{"timeout", Err[string](errTimeout)},
+		{"otherwise unknown", Err[string](errors.New("unknown"))},
+		{"validation email", Err[string](fmt.Errorf("wrapped: %w", &validationError{"email"}))},
+		{"status 500", Err[string](errors.Join(errors.New("unknown"), statusError{500}))},
+		{"validation name", Err[string](errors.Join(statusError{500}, &validationError{"name"}))},
+	}
+
+	match := MatchErr(
+		func(val string) string { return "ok " + val },
+		func(err error) string { return "otherwise " + err.Error() },
+		On(func(err *validationError) string { return "validation " + err.field }),
+		On(func(err statusError) string { return fmt.Sprintf("status %d", err.code) }),
+		OnIs(errTimeout, func(err error) string { return err.Error() }),
+	)
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := match(tt.data); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNil is the error of a Result monad that was converted from an error
+// state, but the new error was `nil`. Converting an error into a `nil` error
+// would otherwise silently turn the failure into Ok with a zero value
+var ErrNil = errors.New("result: nil error")
+
+// MapErr function passes the error of the Result monad to function `f` as a
+// parameter and returns a new Result monad with the error returned by `f`. The
+// successful value is propagated as is. If function `f` returns `nil`, the
+// error is ErrNil, use Recover to turn an error into a successful value. For a
+// TracedError, function `f` is passed the wrapped error and the trace is kept
+func MapErr[A any](f func(error) error) func(Result[A]) Result[A] {
+	return func(m Result[A]) Result[A] {
+		if IsErr(m) {
+			err := mapTraced(m.err, f)
+			if err == nil {
+				err = ErrNil
+			}
+			return Err[A](err)
+		}
+		return m
+	}
+}
+
+// WrapErr wraps the error of the Result monad with the given message `msg`
+// using fmt.Errorf and the `%w` verb, so that the original error can still be
+// inspected with errors.Is and errors.As
+func WrapErr[A any](msg string) func(Result[A]) Result[A] {
+	return MapErr[A](func(err error) error { return fmt.Errorf("%s: %w", msg, err) })
+}
+
+// OrElse passes the error of the Result monad to function `f`, which returns
+// an alternative Result monad. The successful value is propagated as is
+func OrElse[A any](f func(error) Result[A]) func(Result[A]) Result[A] {
+	return func(m Result[A]) Result[A] {
+		if IsErr(m) {
+			return f(m.err)
+		}
+		return m
+	}
+}
+
+// Recover recovers from the error of the Result monad with the value returned
+// by function `f`. The resulting Result monad is always Ok
+func Recover[A any](f func(error) A) func(Result[A]) Result[A] {
+	return OrElse(func(err error) Result[A] { return Ok(f(err)) })
+}
+
+// RecoverIf recovers from the error of the Result monad with the value
+// returned by function `f`, only if the error satisfies the predicate `p`.
+// Predicates `Is` and `As` can be used to match the error with errors.Is and
+// errors.As
+func RecoverIf[A any](p func(error) bool, f func(error) A) func(Result[A]) Result[A] {
+	return OrElse(func(err error) Result[A] {
+		if p(err) {
+			return Ok(f(err))
+		}
+		return Result[A]{err: err}
+	})
+}
+
+// Is returns a predicate that reports whether an error matches the `target`
+// error using errors.Is
+func Is(target error) func(error) bool {
+	return func(err error) bool { return errors.Is(err, target) }
+}
+
+// As returns a predicate that reports whether an error matches the error type
+// `E` using errors.As
+func As[E error]() func(error) bool {
+	return func(err error) bool {
+		var target E
+		return errors.As(err, &target)
+	}
+}
+
+// Tap calls function `f` with the successful value of the Result monad for
+// side effects, such as logging, and returns the Result monad unchanged
+func Tap[A any](f func(A)) func(Result[A]) Result[A] {
+	return func(m Result[A]) Result[A] {
+		if IsOk(m) {
+			f(m.val)
+		}
+		return m
+	}
+}
+
+// TapErr calls function `f` with the error of the Result monad for side
+// effects, such as logging, and returns the Result monad unchanged
+func TapErr[A any](f func(error)) func(Result[A]) Result[A] {
+	return func(m Result[A]) Result[A] {
+		if IsErr(m) {
+			f(m.err)
+		}
+		return m
+	}
+}
@@ -0,0 +1,135 @@
+package result
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestMapErr(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Result[string]
+	}{
+		{"success", Ok("success")},
+		{"NOT FOUND", Err[string](errNotFound)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := MapErr[string](func(err error) error { return errors.New(strings.ToUpper(err.Error())) })(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestMapErrNil(t *testing.T) {
+	result := MapErr[string](func(error) error { return nil })(Err[string](errNotFound))
+
+	if !errors.Is(result.err, ErrNil) {
+		t.Errorf("expected %v, but got %v", ErrNil, show(result))
+	}
+}
+
+func TestWrapErr(t *testing.T) {
+	result := pipe.Pipe2(
+		WrapErr[string]("fetching user"),
+		WrapErr[string]("handling request"),
+	)(Err[string](errNotFound))
+
+	if res, expected := show(result), "handling request: fetching user: not found"; res != expected {
+		t.Errorf("expected %s, but got %s", expected, res)
+	}
+
+	if !errors.Is(result.err, errNotFound) {
+		t.Errorf("expected wrapped error to match %s", errNotFound)
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Result[string]
+	}{
+		{"success", Ok("success")},
+		{"fallback", Err[string](errNotFound)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := OrElse(func(error) Result[string] { return Ok("fallback") })(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestRecover(t *testing.T) {
+	result := Recover(func(err error) string { return "recovered from " + err.Error() })(Err[string](errNotFound))
+
+	if res, expected := show(result), "recovered from not found"; res != expected {
+		t.Errorf("expected %s, but got %s", expected, res)
+	}
+}
+
+func TestRecoverIf(t *testing.T) {
+	_, pathErr := os.Open("does-not-exist")
+
+	tests := []struct {
+		expected string
+		pred     func(error) bool
+		data     Result[string]
+	}{
+		{"success", Is(errNotFound), Ok("success")},
+		{"recovered", Is(errNotFound), Err[string](errNotFound)},
+		{"other", Is(errNotFound), Err[string](errors.New("other"))},
+		{"recovered", Is(errNotFound), WrapErr[string]("context")(Err[string](errNotFound))},
+		{"recovered", Is(fs.ErrNotExist), Err[string](pathErr)},
+		{"recovered", As[*fs.PathError](), Err[string](pathErr)},
+		{"not found", As[*fs.PathError](), Err[string](errNotFound)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := RecoverIf(tt.pred, func(error) string { return "recovered" })(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestTap(t *testing.T) {
+	var (
+		vals []string
+		errs []error
+	)
+
+	tap := pipe.Pipe2(
+		Tap(func(val string) { vals = append(vals, val) }),
+		TapErr[string](func(err error) { errs = append(errs, err) }),
+	)
+
+	tap(Ok("success"))
+	tap(Err[string](errNotFound))
+
+	if len(vals) != 1 || vals[0] != "success" {
+		t.Errorf("expected %v, but got %v", []string{"success"}, vals)
+	}
+
+	if len(errs) != 1 || errs[0] != errNotFound {
+		t.Errorf("expected %v, but got %v", []error{errNotFound}, errs)
+	}
+}
@@ -14,8 +14,6 @@
 // either the value or the error, and not value or nothing.
 package result
 
-import "reflect"
-
 // Result monad data type representation. Contains either value `a` or an
 // `error`
 type Result[A any] struct {
@@ -27,7 +25,8 @@ type Result[A any] struct {
 // if Result monad contains an error
 func Unwrap[A any](m Result[A]) A {
 	if IsErr(m) {
-		return reflect.Zero(reflect.TypeOf(m.val)).Interface().(A)
+		var zero A
+		return zero
 	}
 	return m.val
 }
@@ -62,7 +61,7 @@ func From[A any](val A, err ...error) Result[A] {
 		return Ok(val)
 	}
 
-	return Err[A](err[0])
+	return errAt[A](err[0], 1)
 }
 
 // Ok is the return operation for Result monad that returns the representation
@@ -72,9 +71,10 @@ func Ok[A any](val A) Result[A] {
 }
 
 // Err is the return operation for Result monad that returns the representation
-// of failing operation
+// of failing operation. When tracing is enabled with SetTrace, the error is
+// wrapped into a TracedError that records the caller frames
 func Err[A any](err error) Result[A] {
-	return Result[A]{err: err}
+	return errAt[A](err, 1)
 }
 
 // Map function takes the contents of the Result monad and passes
@@ -83,7 +83,7 @@ func Err[A any](err error) Result[A] {
 func Map[A, B any](f func(A) B) func(Result[A]) Result[B] {
 	return func(m Result[A]) Result[B] {
 		if IsErr(m) {
-			return Err[B](m.err)
+			return Result[B]{err: skip(m.err, "Map", f)}
 		}
 		return Ok(f(m.val))
 	}
@@ -95,7 +95,7 @@ func Map[A, B any](f func(A) B) func(Result[A]) Result[B] {
 func Fmap[A, B any](f func(A) Result[B]) func(Result[A]) Result[B] {
 	return func(m Result[A]) Result[B] {
 		if IsErr(m) {
-			return Err[B](m.err)
+			return Result[B]{err: skip(m.err, "Fmap", f)}
 		}
 		return f(m.val)
 	}
@@ -163,3 +163,56 @@ func TestUnsafeUnwrap(t *testing.T) {
 		})
 	}
 }
+
+func TestUnwrapInterface(t *testing.T) {
+	if result := Unwrap(Err[error](errors.New("failure"))); result != nil {
+		t.Errorf("expected nil, but got %v", result)
+	}
+
+	if result := Unwrap(Err[any](errors.New("failure"))); result != nil {
+		t.Errorf("expected nil, but got %v", result)
+	}
+
+	if result := Unwrap(Err[*int](errors.New("failure"))); result != nil {
+		t.Errorf("expected nil, but got %v", result)
+	}
+}
+
+var (
+	sinkString string
+	sinkFloat  Result[float32]
+)
+
+func BenchmarkUnwrap(b *testing.B) {
+	m := Err[string](errors.New("failure"))
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkString = Unwrap(m)
+	}
+}
+
+func BenchmarkMapFmap(b *testing.B) {
+	var (
+		double = Map(func(x int) int { return x * 2 })
+		inv    = Fmap(inverse)
+	)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkFloat = inv(double(Ok(i)))
+	}
+}
+
+func BenchmarkMapFmapErr(b *testing.B) {
+	var (
+		err    = Err[int](errors.New("failure"))
+		double = Map(func(x int) int { return x * 2 })
+		inv    = Fmap(inverse)
+	)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sinkFloat = inv(double(err))
+	}
+}
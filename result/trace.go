@@ -0,0 +1,192 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// maxFrames is the maximum amount of caller frames recorded by a TracedError
+const maxFrames = 32
+
+var tracing atomic.Bool
+
+// traced is set once the first TracedError has been created. Until then the
+// propagated errors cannot contain a trace, and are not searched for one
+var traceUsed atomic.Bool
+
+// SetTrace enables or disables tracing for the errors created with Err and
+// From. Tracing is disabled by default, as capturing the caller frames has a
+// cost for every error
+func SetTrace(enabled bool) {
+	tracing.Store(enabled)
+}
+
+// TracedError wraps an error of the Result monad with the caller frames where
+// the error was created, and the Map and Fmap stages that were skipped while
+// the error was propagated. TracedError can be retrieved from an error with
+// errors.As, and formatted with the `%+v` verb to print the trace
+type TracedError struct {
+	err    error
+	frames []runtime.Frame
+	stages []string
+}
+
+// ErrWithTrace is the return operation for Result monad that returns the
+// representation of failing operation with the error wrapped into a
+// TracedError, regardless of whether tracing is enabled
+func ErrWithTrace[A any](err error) Result[A] {
+	return Result[A]{err: trace(err, 1)}
+}
+
+// Error implements the error interface. The message is the message of the
+// wrapped error
+func (e *TracedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error
+func (e *TracedError) Unwrap() error {
+	return e.err
+}
+
+// Frames returns the caller frames where the error was created, starting from
+// the innermost caller
+func (e *TracedError) Frames() []runtime.Frame {
+	return append([]runtime.Frame(nil), e.frames...)
+}
+
+// Stages returns the Map and Fmap stages that were skipped while the error
+// was propagated, in the order they were skipped
+func (e *TracedError) Stages() []string {
+	return append([]string(nil), e.stages...)
+}
+
+// Format implements the fmt.Formatter interface. The `%+v` verb prints the
+// error message followed by the caller frames and the skipped stages, other
+// verbs print the error message only
+func (e *TracedError) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		io.WriteString(s, e.Error())
+		io.WriteString(s, "\norigin:")
+		for _, f := range e.frames {
+			fmt.Fprintf(s, "\n\t%s\n\t\t%s:%d", f.Function, f.File, f.Line)
+		}
+		if len(e.stages) > 0 {
+			io.WriteString(s, "\nskipped:")
+			for _, stage := range e.stages {
+				fmt.Fprintf(s, "\n\t%s", stage)
+			}
+		}
+	case verb == 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		io.WriteString(s, e.Error())
+	}
+}
+
+// internal
+func errAt[A any](err error, skip int) Result[A] {
+	if tracing.Load() {
+		err = trace(err, skip+1)
+	}
+	return Result[A]{err: err}
+}
+
+// trace wraps the error into a TracedError recording the caller frames,
+// skipping `skip` frames above the caller of trace. Errors that are already
+// traced are returned as is
+func trace(err error, skip int) error {
+	var traced *TracedError
+	if err == nil || errors.As(err, &traced) {
+		return err
+	}
+
+	traceUsed.Store(true)
+
+	pcs := make([]uintptr, maxFrames)
+	n := runtime.Callers(skip+2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	e := &TracedError{err: err}
+	for {
+		f, more := frames.Next()
+		e.frames = append(e.frames, f)
+		if !more {
+			break
+		}
+	}
+	return e
+}
+
+// skip records the skipped stage on a traced error. The error is copied so
+// that the errors shared by other Result monads are not modified. If the
+// TracedError is wrapped by another error, the whole error is kept and the
+// trace is moved to the outermost error
+func skip(err error, name string, f any) error {
+	if !traceUsed.Load() {
+		return err
+	}
+
+	t := findTrace(err)
+	if t == nil {
+		return err
+	}
+
+	inner := t.err
+	if err != error(t) {
+		inner = err
+	}
+
+	stages := make([]string, len(t.stages), len(t.stages)+1)
+	copy(stages, t.stages)
+	return &TracedError{
+		err:    inner,
+		frames: t.frames,
+		stages: append(stages, fmt.Sprintf("%s(%s)", name, funcName(f))),
+	}
+}
+
+// findTrace returns the TracedError of the error chain, or `nil` if the error
+// is not traced. The outermost error is checked first without errors.As
+func findTrace(err error) *TracedError {
+	if t, ok := err.(*TracedError); ok {
+		return t
+	}
+
+	var t *TracedError
+	if errors.As(err, &t) {
+		return t
+	}
+	return nil
+}
+
+// mapTraced passes the error to function `f`. If the error is a TracedError,
+// function `f` is passed the wrapped error and the returned error is traced
+// with the same frames and stages, so that the TracedError stays outermost
+func mapTraced(err error, f func(error) error) error {
+	traced, ok := err.(*TracedError)
+	if !ok {
+		return f(err)
+	}
+
+	mapped := f(traced.err)
+	if mapped == nil {
+		return nil
+	}
+	return &TracedError{err: mapped, frames: traced.frames, stages: traced.stages}
+}
+
+func funcName(f any) string {
+	fn := runtime.FuncForPC(reflect.ValueOf(f).Pointer())
+	if fn == nil {
+		return "unknown"
+	}
+	name := fn.Name()
+	return name[strings.LastIndex(name, "/")+1:]
+}
@@ -0,0 +1,156 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+func TestErrWithTrace(t *testing.T) {
+	result := pipe.Pipe3(
+		func(int) Result[int] { return ErrWithTrace[int](errNotFound) },
+		Fmap(inverse),
+		Map(func(x float32) string { return fmt.Sprint(x) }),
+	)(0)
+
+	var traced *TracedError
+	if !errors.As(result.err, &traced) {
+		t.Fatalf("expected a *TracedError, but got %v", result.err)
+	}
+
+	if !errors.Is(result.err, errNotFound) {
+		t.Errorf("expected %s, but got %s", errNotFound, result.err)
+	}
+
+	if frames := traced.Frames(); len(frames) == 0 || !strings.HasSuffix(frames[0].Function, "TestErrWithTrace.func1") {
+		t.Errorf("expected the origin to be TestErrWithTrace.func1, but got %v", frames)
+	}
+
+	stages := traced.Stages()
+	if len(stages) != 2 || stages[0] != "Fmap(result.inverse)" || !strings.HasPrefix(stages[1], "Map(result.TestErrWithTrace.func") {
+		t.Errorf("expected the skipped stages, but got %v", stages)
+	}
+}
+
+func TestSetTrace(t *testing.T) {
+	SetTrace(true)
+	t.Cleanup(func() { SetTrace(false) })
+
+	tests := []Result[string]{
+		Err[string](errNotFound),
+		From("", errNotFound),
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			var traced *TracedError
+			if !errors.As(tt.err, &traced) {
+				t.Fatalf("expected a *TracedError, but got %v", tt.err)
+			}
+
+			if frames := traced.Frames(); !strings.HasSuffix(frames[0].Function, "TestSetTrace") {
+				t.Errorf("expected the origin to be TestSetTrace, but got %s", frames[0].Function)
+			}
+		})
+	}
+}
+
+func TestTraceDisabled(t *testing.T) {
+	result := Map(strings.ToUpper)(Err[string](errNotFound))
+
+	if result.err != errNotFound {
+		t.Errorf("expected %v, but got %#v", errNotFound, result.err)
+	}
+}
+
+func TestTraceNotRecaptured(t *testing.T) {
+	SetTrace(true)
+	t.Cleanup(func() { SetTrace(false) })
+
+	origin := ErrWithTrace[int](errNotFound)
+	result := Sequence([]Result[int]{Ok(1), origin})
+
+	if result.err != origin.err {
+		t.Errorf("expected the error to be propagated as is")
+	}
+}
+
+func TestTracedErrorFormat(t *testing.T) {
+	result := Map(strings.ToUpper)(ErrWithTrace[string](errNotFound))
+
+	tests := []struct {
+		expected string
+		format   string
+	}{
+		{"not found", "%v"},
+		{"not found", "%s"},
+		{`"not found"`, "%q"},
+		{"not found\norigin:\n\tgithub.com/erikjuhani/go-fp/result.TestTracedErrorFormat\n", "%+v"},
+		{"\nskipped:\n\tMap(strings.ToUpper)", "%+v"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if res := fmt.Sprintf(tt.format, result.err); !strings.Contains(res, tt.expected) {
+				t.Errorf("expected %s to contain %s", res, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTraceWrapErr(t *testing.T) {
+	result := pipe.Pipe3(
+		func(int) Result[int] { return ErrWithTrace[int](errNotFound) },
+		WrapErr[int]("ctx"),
+		Map(func(x int) int { return x }),
+	)(0)
+
+	traced, ok := result.err.(*TracedError)
+	if !ok {
+		t.Fatalf("expected a *TracedError, but got %T", result.err)
+	}
+
+	if !errors.Is(result.err, errNotFound) {
+		t.Errorf("expected %s, but got %s", errNotFound, result.err)
+	}
+
+	if stages := traced.Stages(); len(stages) != 1 || !strings.HasPrefix(stages[0], "Map(result.TestTraceWrapErr.func") {
+		t.Errorf("expected the skipped stages, but got %v", stages)
+	}
+
+	res := fmt.Sprintf("%+v", result.err)
+	for _, expected := range []string{
+		"ctx: not found\norigin:\n\tgithub.com/erikjuhani/go-fp/result.TestTraceWrapErr.func1\n",
+		"\nskipped:\n\tMap(result.TestTraceWrapErr.func",
+	} {
+		if !strings.Contains(res, expected) {
+			t.Errorf("expected %s to contain %s", res, expected)
+		}
+	}
+}
+
+func TestTraceWrappedByFmap(t *testing.T) {
+	result := pipe.Pipe3(
+		func(int) Result[int] { return Ok(0) },
+		Fmap(func(int) Result[int] {
+			return Err[int](fmt.Errorf("ctx: %w", ErrWithTrace[int](errNotFound).err))
+		}),
+		Map(func(x int) int { return x }),
+	)(0)
+
+	traced, ok := result.err.(*TracedError)
+	if !ok {
+		t.Fatalf("expected a *TracedError, but got %T", result.err)
+	}
+
+	if result.err.Error() != "ctx: not found" {
+		t.Errorf("expected %s, but got %s", "ctx: not found", result.err)
+	}
+
+	if stages := traced.Stages(); len(stages) != 1 {
+		t.Errorf("expected the skipped stages, but got %v", stages)
+	}
+}
@@ -0,0 +1,36 @@
+package result
+
+import (
+	"runtime/debug"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+// Try calls function `f` and returns its value as Ok. If function `f` panics,
+// the panic is recovered and returned as Err containing a *pipe.PanicError,
+// which carries the panic value and the stack trace at the time of the panic.
+// The panic value can be inspected with errors.Is and errors.As if it is an
+// error
+func Try[A any](f func() A) (m Result[A]) {
+	ok := false
+	defer func() {
+		if !ok {
+			m = errAt[A](&pipe.PanicError{Value: recover(), Stack: debug.Stack()}, 1)
+		}
+	}()
+
+	val := f()
+	ok = true
+	return Ok(val)
+}
+
+// TryFunc lifts function `f` into a function that returns the value of `f` as
+// Ok, or the recovered panic as Err like Try. The lifted function can be used
+// to guard calls to code that may panic in Map and Fmap chains
+//
+//	pipe.Pipe2(head[string], result.Fmap(result.TryFunc(mustParse)))
+func TryFunc[A, B any](f func(A) B) func(A) Result[B] {
+	return func(a A) Result[B] {
+		return Try(func() B { return f(a) })
+	}
+}
@@ -0,0 +1,111 @@
+package result
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+func TestTry(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	tests := []struct {
+		expected string
+		value    any
+		f        func() int
+	}{
+		{"1", nil, func() int { return 1 }},
+		{"panic: boom", "boom", func() int { panic("boom") }},
+		{"panic: boom", errBoom, func() int { panic(errBoom) }},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Try(tt.f)
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+
+			if IsOk(result) {
+				return
+			}
+
+			var panicErr *pipe.PanicError
+			if !errors.As(result.err, &panicErr) {
+				t.Fatalf("expected a *pipe.PanicError, but got %T", result.err)
+			}
+			if panicErr.Value != tt.value {
+				t.Errorf("expected %v, but got %v", tt.value, panicErr.Value)
+			}
+			if !strings.Contains(string(panicErr.Stack), "TestTry") {
+				t.Errorf("expected the stack to contain TestTry, but got %s", panicErr.Stack)
+			}
+		})
+	}
+}
+
+func TestTryUnwrap(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	result := Try(func() int { panic(errBoom) })
+	if !errors.Is(result.err, errBoom) {
+		t.Errorf("expected %v to wrap %v", result.err, errBoom)
+	}
+
+	result = Try(func() int { var m map[string]int; m["a"] = 1; return 0 })
+	var runtimeErr interface{ RuntimeError() }
+	if !errors.As(result.err, &runtimeErr) {
+		t.Errorf("expected %v to wrap a runtime error", result.err)
+	}
+}
+
+func TestTryNil(t *testing.T) {
+	if result := Try(func() int { panic(nil) }); IsOk(result) {
+		t.Errorf("expected an error, but got %v", show(result))
+	}
+}
+
+func TestTryFunc(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []int
+	}{
+		{"cannot get head from an empty array", []int{}},
+		{"panic: runtime error: integer divide by zero", []int{0}},
+		{"5", []int{2}},
+	}
+
+	div := TryFunc(func(x int) int { return 10 / x })
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(head[int], Fmap(div))(tt.data)
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %v, but got %v", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestTryTrace(t *testing.T) {
+	SetTrace(true)
+	t.Cleanup(func() { SetTrace(false) })
+
+	result := Try(func() int { panic("boom") })
+
+	var traced *TracedError
+	if !errors.As(result.err, &traced) {
+		t.Fatalf("expected a *TracedError, but got %T", result.err)
+	}
+
+	var panicErr *pipe.PanicError
+	if !errors.As(result.err, &panicErr) {
+		t.Fatalf("expected a *pipe.PanicError, but got %T", result.err)
+	}
+
+	if len(traced.Frames()) == 0 {
+		t.Errorf("expected the caller frames")
+	}
+}
@@ -0,0 +1,80 @@
+package result
+
+import "github.com/erikjuhani/go-fp/tuple"
+
+// Zip combines two Result monads into a Result monad of a tuple.Pair. The
+// result is Ok only if both Result monads are Ok, otherwise the first error is
+// returned
+func Zip[A, B any](a Result[A], b Result[B]) Result[tuple.Pair[A, B]] {
+	return Map2(tuple.NewPair[A, B])(a, b)
+}
+
+// Zip3 combines three Result monads into a Result monad of a tuple.Triple.
+// The result is Ok only if all Result monads are Ok, otherwise the first error
+// is returned
+func Zip3[A, B, C any](a Result[A], b Result[B], c Result[C]) Result[tuple.Triple[A, B, C]] {
+	return Map3(tuple.NewTriple[A, B, C])(a, b, c)
+}
+
+// Ap applies the function contained in a Result monad to the value of the
+// Result monad `m`. The error of the function is returned first, and then the
+// error of `m`
+func Ap[A, B any](m Result[A]) func(Result[func(A) B]) Result[B] {
+	return func(mf Result[func(A) B]) Result[B] {
+		return Fmap(func(f func(A) B) Result[B] { return Map(f)(m) })(mf)
+	}
+}
+
+// Map2 applies function `f` to the values of two Result monads if both are
+// Ok. Otherwise the first error is returned
+func Map2[A, B, C any](f func(A, B) C) func(Result[A], Result[B]) Result[C] {
+	return func(a Result[A], b Result[B]) Result[C] {
+		if err := firstErr(a.err, b.err); err != nil {
+			return Result[C]{err: err}
+		}
+		return Ok(f(a.val, b.val))
+	}
+}
+
+// Map3 applies function `f` to the values of three Result monads if all are
+// Ok. Otherwise the first error is returned
+func Map3[A, B, C, D any](f func(A, B, C) D) func(Result[A], Result[B], Result[C]) Result[D] {
+	return func(a Result[A], b Result[B], c Result[C]) Result[D] {
+		if err := firstErr(a.err, b.err, c.err); err != nil {
+			return Result[D]{err: err}
+		}
+		return Ok(f(a.val, b.val, c.val))
+	}
+}
+
+// Map4 applies function `f` to the values of four Result monads if all are
+// Ok. Otherwise the first error is returned
+func Map4[A, B, C, D, E any](f func(A, B, C, D) E) func(Result[A], Result[B], Result[C], Result[D]) Result[E] {
+	return func(a Result[A], b Result[B], c Result[C], d Result[D]) Result[E] {
+		if err := firstErr(a.err, b.err, c.err, d.err); err != nil {
+			return Result[E]{err: err}
+		}
+		return Ok(f(a.val, b.val, c.val, d.val))
+	}
+}
+
+// Map5 applies function `f` to the values of five Result monads if all are
+// Ok. Otherwise the first error is returned
+func Map5[A, B, C, D, E, F any](f func(A, B, C, D, E) F) func(Result[A], Result[B], Result[C], Result[D], Result[E]) Result[F] {
+	return func(a Result[A], b Result[B], c Result[C], d Result[D], e Result[E]) Result[F] {
+		if err := firstErr(a.err, b.err, c.err, d.err, e.err); err != nil {
+			return Result[F]{err: err}
+		}
+		return Ok(f(a.val, b.val, c.val, d.val, e.val))
+	}
+}
+
+// internal
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/tuple"
+)
+
+var (
+	errFirst  = errors.New("first")
+	errSecond = errors.New("second")
+)
+
+func TestZip(t *testing.T) {
+	tests := []struct {
+		expected string
+		a        Result[int]
+		b        Result[string]
+	}{
+		{"(1, one)", Ok(1), Ok("one")},
+		{"first", Err[int](errFirst), Ok("one")},
+		{"second", Ok(1), Err[string](errSecond)},
+		{"first", Err[int](errFirst), Err[string](errSecond)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(Zip(tt.a, tt.b)); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestZip3(t *testing.T) {
+	tests := []struct {
+		expected string
+		result   Result[tuple.Triple[int, string, bool]]
+	}{
+		{"(1, one, true)", Zip3(Ok(1), Ok("one"), Ok(true))},
+		{"second", Zip3(Ok(1), Err[string](errSecond), Ok(true))},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(tt.result); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMapN(t *testing.T) {
+	var (
+		sum2 = func(a, b int) int { return a + b }
+		sum3 = func(a, b, c int) int { return a + b + c }
+		sum4 = func(a, b, c, d int) int { return a + b + c + d }
+		sum5 = func(a, b, c, d, e int) int { return a + b + c + d + e }
+		one  = Ok(1)
+		err1 = Err[int](errFirst)
+		err2 = Err[int](errSecond)
+	)
+
+	tests := []struct {
+		expected string
+		result   Result[int]
+	}{
+		{"2", Map2(sum2)(one, one)},
+		{"first", Map2(sum2)(one, err1)},
+		{"3", Map3(sum3)(one, one, one)},
+		{"second", Map3(sum3)(one, err2, err1)},
+		{"4", Map4(sum4)(one, one, one, one)},
+		{"first", Map4(sum4)(one, one, one, err1)},
+		{"5", Map5(sum5)(one, one, one, one, one)},
+		{"second", Map5(sum5)(one, one, err2, one, err1)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(tt.result); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestAp(t *testing.T) {
+	double := func(x int) string { return fmt.Sprint(x * 2) }
+
+	tests := []struct {
+		expected string
+		f        Result[func(int) string]
+		m        Result[int]
+	}{
+		{"4", Ok(double), Ok(2)},
+		{"second", Ok(double), Err[int](errSecond)},
+		{"first", Err[func(int) string](errFirst), Err[int](errSecond)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := show(Ap[int, string](tt.m)(tt.f)); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package state
+
+import "github.com/erikjuhani/go-fp/result"
+
+// StateResult represents the state monad type combined with Result monad,
+// which is a stateful computation that can potentially fail. The state is
+// threaded through the computation and the computation is short-circuited on
+// the first error
+type StateResult[A, S any] func(S) (result.Result[A], S)
+
+// RunResult accesses the state processing function enabling to reach the
+// function to operate on the state itself.
+func RunResult[S any](s S) StateResult[S, S] {
+	return func(S) (result.Result[S], S) {
+		return result.Ok(s), s
+	}
+}
+
+// Lift lifts the State monad into StateResult monad that always succeeds
+func Lift[A, S any](m State[A, S]) StateResult[A, S] {
+	return func(s1 S) (result.Result[A], S) {
+		a, s2 := m(s1)
+		return result.Ok(a), s2
+	}
+}
+
+// FromResult lifts the Result monad into StateResult monad without modifying
+// the state
+func FromResult[S, A any](m result.Result[A]) StateResult[A, S] {
+	return func(s S) (result.Result[A], S) {
+		return m, s
+	}
+}
+
+// Fail fails the stateful computation with the given error without modifying
+// the state
+func Fail[A, S any](err error) StateResult[A, S] {
+	return func(s S) (result.Result[A], S) {
+		return result.Err[A](err), s
+	}
+}
+
+// GetResult retrieves the current state without modifying it and sets it as
+// the successful result `(Result, State)`
+func GetResult[S any](S) StateResult[S, S] {
+	return func(s S) (result.Result[S], S) {
+		return result.Ok(s), s
+	}
+}
+
+// PutResult replaces the current state with a new state. PutResult does not
+// yield any meaningful result, it only sets the state to a new provided state
+// `a`
+func PutResult[A any](s A) StateResult[Void, A] {
+	return Lift(Put(s))
+}
+
+// ModifyResult transforms the current state based on the given function `f`.
+// ModifyResult does not yield any meaningful result, it only updates the state
+// to a new state based on the given function `f`
+func ModifyResult[S any](f func(S) S) StateResult[Void, S] {
+	return Lift(Modify(f))
+}
+
+// ExecResult discards the computed result and returns only the final state as
+// Result monad. If the computation failed, the error is returned instead of
+// the state.
+func ExecResult[A, S any](s S) func(StateResult[A, S]) result.Result[S] {
+	return func(m StateResult[A, S]) result.Result[S] {
+		a, r := m(s)
+		return result.Map(func(A) S { return r })(a)
+	}
+}
+
+// EvalResult discards the final state and returns only the computed result as
+// Result monad.
+func EvalResult[A, S any](s S) func(StateResult[A, S]) result.Result[A] {
+	return func(m StateResult[A, S]) result.Result[A] {
+		a, _ := m(s)
+		return a
+	}
+}
+
+// MapResult function takes the successful contents of the StateResult monad
+// and passes it to function `f` as a parameter. The function `f` returns a new
+// StateResult monad as the result
+func MapResult[S, A, B any](f func(A) B) func(StateResult[A, S]) StateResult[B, S] {
+	return func(m StateResult[A, S]) StateResult[B, S] {
+		return func(s1 S) (result.Result[B], S) {
+			a, s2 := m(s1)
+			return result.Map(f)(a), s2
+		}
+	}
+}
+
+// FmapResult or also known as `bind` function lets non-monadic function `f` to
+// operate on the successful contents of monad m a, and lifts the value to a
+// new domain (StateResult a -> StateResult b). The function `f` is not called
+// if the computation has failed, and the state is left as it was at the point
+// of failure.
+func FmapResult[A, B, S any](f func(A) StateResult[B, S]) func(StateResult[A, S]) StateResult[B, S] {
+	return func(m StateResult[A, S]) StateResult[B, S] {
+		return func(s1 S) (result.Result[B], S) {
+			a, s2 := m(s1)
+			s3 := s2
+			b := result.Fmap(func(a A) result.Result[B] {
+				var r result.Result[B]
+				r, s3 = f(a)(s2)
+				return r
+			})(a)
+			return b, s3
+		}
+	}
+}
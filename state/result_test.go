@@ -0,0 +1,167 @@
+package state
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+	"github.com/erikjuhani/go-fp/result"
+)
+
+// pop removes the top of the stack and fails if the stack is empty
+func pop(stack []int) (result.Result[int], []int) {
+	if len(stack) == 0 {
+		return result.Err[int](errors.New("stack is empty")), stack
+	}
+	return result.Ok(stack[len(stack)-1]), stack[:len(stack)-1]
+}
+
+func push(x int) StateResult[Void, []int] {
+	return ModifyResult(func(stack []int) []int { return append(stack, x) })
+}
+
+// add pops two values from the stack and pushes their sum
+func add([]int) StateResult[Void, []int] {
+	return FmapResult(func(a int) StateResult[Void, []int] {
+		return FmapResult(func(b int) StateResult[Void, []int] {
+			return push(a + b)
+		})(pop)
+	})(pop)
+}
+
+func show[A any](m result.Result[A]) string {
+	return result.Match(
+		func(err error) string { return err.Error() },
+		func(val A) string { return fmt.Sprint(val) },
+	)(m)
+}
+
+func TestExecResult(t *testing.T) {
+	tests := []struct {
+		expected     string
+		initialState []int
+	}{
+		{"stack is empty", []int{}},
+		{"stack is empty", []int{1}},
+		{"[3]", []int{1, 2}},
+		{"[1 5]", []int{1, 2, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe3(
+				RunResult[[]int],
+				FmapResult(add),
+				ExecResult[Void]([]int{}),
+			)(tt.initialState)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestEvalResult(t *testing.T) {
+	tests := []struct {
+		expected     string
+		initialState []int
+	}{
+		{"stack is empty", []int{}},
+		{"2", []int{1, 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe3(
+				RunResult[[]int],
+				FmapResult(func([]int) StateResult[int, []int] { return pop }),
+				EvalResult[int]([]int{}),
+			)(tt.initialState)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestFmapResultShortCircuit(t *testing.T) {
+	called := false
+
+	m := pipe.Pipe3(
+		FmapResult(func(int) StateResult[Void, int] { return PutResult(1) }),
+		FmapResult(func(Void) StateResult[Void, int] { return Fail[Void, int](errors.New("failure")) }),
+		FmapResult(func(Void) StateResult[Void, int] {
+			called = true
+			return PutResult(2)
+		}),
+	)(RunResult(0))
+
+	res, s := m(0)
+
+	if called {
+		t.Errorf("expected computation to short-circuit")
+	}
+
+	if r := show(res); r != "failure" {
+		t.Errorf("expected %s, but got %s", "failure", r)
+	}
+
+	if s != 1 {
+		t.Errorf("expected %d, but got %d", 1, s)
+	}
+}
+
+func TestLift(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     result.Result[int]
+	}{
+		{"failure", result.Err[int](errors.New("failure"))},
+		{"2", result.Ok(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe3(
+				FromResult[int, int],
+				FmapResult(func(x int) StateResult[int, int] { return Lift(GetS(func(s int) int { return x + s })) }),
+				EvalResult[int](1),
+			)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestVoidResult(t *testing.T) {
+	validate := result.LiftErr(func(s int) error {
+		if s < 0 {
+			return errors.New("negative state")
+		}
+		return nil
+	})
+
+	check := func(s int) StateResult[Void, int] { return FromResult[int](validate(s)) }
+
+	tests := []struct {
+		expected string
+		data     int
+	}{
+		{"{}", 1},
+		{"negative state", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			res, _ := check(tt.data)(tt.data)
+			if s := show(res); s != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, s)
+			}
+		})
+	}
+}
@@ -12,6 +12,8 @@
 // modular
 package state
 
+import "github.com/erikjuhani/go-fp/result"
+
 // State represents the state monad type, which holds a state in pure
 // functional context and the state transformation itself is encapsulated
 // within the monad construct
@@ -19,7 +21,7 @@ type State[A, S any] func(S) (A, S)
 
 // Void represents an unit or void that is present in an operation that does
 // not produce a result
-type Void struct{}
+type Void = result.Void
 
 // Run accesses the state processing function enabling to reach the function to
 // operate on the state itself.
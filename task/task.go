@@ -0,0 +1,158 @@
+// Task monad represents a lazy, possibly asynchronous computation that
+// produces either a successful value or an error when run. Task is described
+// as a function that takes a context.Context and returns Result monad.
+//
+// Task monad enables to describe deferred effects, such as I/O operations,
+// without executing them. The computation is executed only when the Task is
+// run with `Run`, which makes it possible to compose, retry, parallelize and
+// time out effects before anything has happened.
+//
+// Task monad respects context cancellation. A Task composed with `Map` or
+// `Fmap` checks the context before running the next step, and tasks run with
+// `Par` or `Race` are run in their own goroutines.
+package task
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/erikjuhani/go-fp/result"
+)
+
+// ErrNoTasks is the error of a Race that is given no tasks to run
+var ErrNoTasks = errors.New("task: no tasks to race")
+
+// Task monad data type representation. A lazy computation that produces
+// Result monad when run with a context
+type Task[A any] func(context.Context) result.Result[A]
+
+// Of is the return operation for Task monad that returns a Task that always
+// succeeds with the given value `a`
+func Of[A any](val A) Task[A] {
+	return func(context.Context) result.Result[A] { return result.Ok(val) }
+}
+
+// Fail is the return operation for Task monad that returns a Task that always
+// fails with the given error
+func Fail[A any](err error) Task[A] {
+	return func(context.Context) result.Result[A] { return result.Err[A](err) }
+}
+
+// From lifts a Go function `f` that returns a tuple `(T, error)` into a Task
+func From[A any](f func(context.Context) (A, error)) Task[A] {
+	return func(ctx context.Context) result.Result[A] { return result.From(f(ctx)) }
+}
+
+// Run runs the Task with the given context `ctx` and returns the Result. If
+// the context is already done the Task is not run and the context error is
+// returned instead
+func Run[A any](ctx context.Context) func(Task[A]) result.Result[A] {
+	return func(m Task[A]) result.Result[A] {
+		if err := ctx.Err(); err != nil {
+			return result.Err[A](err)
+		}
+		return m(ctx)
+	}
+}
+
+// Map function takes the contents of the Task monad and passes it to function
+// `f` as a parameter. The function `f` returns a new Task monad as the result
+func Map[A, B any](f func(A) B) func(Task[A]) Task[B] {
+	return func(m Task[A]) Task[B] {
+		return func(ctx context.Context) result.Result[B] {
+			return result.Map(f)(Run[A](ctx)(m))
+		}
+	}
+}
+
+// Fmap or also known as `bind` function lets non-monadic function `f` to
+// operate on the contents of monad m a, and lifts the value to a new domain
+// (Task a -> Task b). The Task returned by `f` is not run if the context is
+// done
+func Fmap[A, B any](f func(A) Task[B]) func(Task[A]) Task[B] {
+	return func(m Task[A]) Task[B] {
+		return func(ctx context.Context) result.Result[B] {
+			return result.Fmap(func(a A) result.Result[B] {
+				return Run[B](ctx)(f(a))
+			})(Run[A](ctx)(m))
+		}
+	}
+}
+
+// Par runs the given tasks concurrently in their own goroutines and joins the
+// results into a slice in the order of the given tasks. If any of the tasks
+// fails, the context of the remaining tasks is cancelled and the first error
+// is returned
+func Par[A any](ms ...Task[A]) Task[[]A] {
+	return func(ctx context.Context) result.Result[[]A] {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		type indexed struct {
+			i int
+			r result.Result[A]
+		}
+
+		ch := make(chan indexed, len(ms))
+		for i, m := range ms {
+			go func(i int, m Task[A]) { ch <- indexed{i, Run[A](ctx)(m)} }(i, m)
+		}
+
+		vals := make([]A, len(ms))
+		for range ms {
+			res := <-ch
+			if result.IsErr(res.r) {
+				return result.Err[[]A](errOf(res.r))
+			}
+			vals[res.i] = result.Unwrap(res.r)
+		}
+		return result.Ok(vals)
+	}
+}
+
+// Race runs the given tasks concurrently in their own goroutines and returns
+// the result of the first task to complete. The context of the remaining tasks
+// is cancelled. Race fails with ErrNoTasks if no tasks are given
+func Race[A any](ms ...Task[A]) Task[A] {
+	return func(ctx context.Context) result.Result[A] {
+		if len(ms) == 0 {
+			return result.Err[A](ErrNoTasks)
+		}
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		ch := make(chan result.Result[A], len(ms))
+		for _, m := range ms {
+			go func(m Task[A]) { ch <- Run[A](ctx)(m) }(m)
+		}
+
+		select {
+		case res := <-ch:
+			return res
+		case <-ctx.Done():
+			return result.Err[A](ctx.Err())
+		}
+	}
+}
+
+// Timeout limits the duration of the Task to `d`. If the Task does not
+// complete in time, the Task fails with context.DeadlineExceeded
+func Timeout[A any](d time.Duration) func(Task[A]) Task[A] {
+	return func(m Task[A]) Task[A] {
+		return func(ctx context.Context) result.Result[A] {
+			ctx, cancel := context.WithTimeout(ctx, d)
+			defer cancel()
+			return Race(m)(ctx)
+		}
+	}
+}
+
+// internal
+func errOf[A any](m result.Result[A]) error {
+	return result.Match(
+		func(err error) error { return err },
+		func(A) error { return nil },
+	)(m)
+}
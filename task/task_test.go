@@ -0,0 +1,166 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/erikjuhani/go-fp/pipe"
+	"github.com/erikjuhani/go-fp/result"
+)
+
+func sleep[A any](d time.Duration, val A) Task[A] {
+	return func(ctx context.Context) result.Result[A] {
+		select {
+		case <-time.After(d):
+			return result.Ok(val)
+		case <-ctx.Done():
+			return result.Err[A](ctx.Err())
+		}
+	}
+}
+
+func parse(s string) Task[int] {
+	return From(func(context.Context) (int, error) { return strconv.Atoi(s) })
+}
+
+func show[A any](m result.Result[A]) string {
+	return result.Match(
+		func(err error) string { return err.Error() },
+		func(val A) string { return fmt.Sprint(val) },
+	)(m)
+}
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Task[int]
+	}{
+		{"failure", Fail[int](errors.New("failure"))},
+		{"2", Of(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				Map(func(x int) int { return x * 2 }),
+				Run[int](context.Background()),
+			)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestFmap(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Task[string]
+	}{
+		{"failure", Fail[string](errors.New("failure"))},
+		{`strconv.Atoi: parsing "x": invalid syntax`, Of("x")},
+		{"42", Of("42")},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				Fmap(parse),
+				Run[int](context.Background()),
+			)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestRunCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	ran := false
+	result := Run[int](ctx)(func(context.Context) result.Result[int] {
+		ran = true
+		return result.Ok(1)
+	})
+
+	if ran {
+		t.Errorf("expected task not to run")
+	}
+
+	if res := show(result); res != context.Canceled.Error() {
+		t.Errorf("expected %s, but got %s", context.Canceled, res)
+	}
+}
+
+func TestPar(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []Task[int]
+	}{
+		{"[]", nil},
+		{"[1 2 3]", []Task[int]{sleep(20*time.Millisecond, 1), sleep(10*time.Millisecond, 2), Of(3)}},
+		{"failure", []Task[int]{sleep(time.Second, 1), Fail[int](errors.New("failure"))}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Run[[]int](context.Background())(Par(tt.data...))
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestRace(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []Task[int]
+	}{
+		{"2", []Task[int]{sleep(time.Second, 1), sleep(time.Millisecond, 2)}},
+		{"1", []Task[int]{Of(1)}},
+		{ErrNoTasks.Error(), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Run[int](context.Background())(Race(tt.data...))
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Task[int]
+	}{
+		{"1", sleep(time.Millisecond, 1)},
+		{context.DeadlineExceeded.Error(), sleep(time.Second, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				Timeout[int](50*time.Millisecond),
+				Run[int](context.Background()),
+			)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// Tuple provides generic product types that hold a fixed number of values of
+// possibly different types, from Pair up to Tuple6.
+//
+// Go functions can return multiple values, but multiple values are not first
+// class citizens, they cannot be stored, passed through a composition of
+// functions or wrapped into a monad. Tuple types make it possible to carry
+// several values as one, for example through `pipe.PipeN`, or as the result
+// of zipping several Result or Maybe monads together.
+package tuple
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// Pair holds two values of possibly different types
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Triple holds three values of possibly different types
+type Triple[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Tuple4 holds four values of possibly different types
+type Tuple4[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Tuple5 holds five values of possibly different types
+type Tuple5[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// Tuple6 holds six values of possibly different types
+type Tuple6[A, B, C, D, E, F any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+	Sixth  F
+}
+
+// NewPair returns a Pair of the given values
+func NewPair[A, B any](a A, b B) Pair[A, B] {
+	return Pair[A, B]{a, b}
+}
+
+// NewTriple returns a Triple of the given values
+func NewTriple[A, B, C any](a A, b B, c C) Triple[A, B, C] {
+	return Triple[A, B, C]{a, b, c}
+}
+
+// NewTuple4 returns a Tuple4 of the given values
+func NewTuple4[A, B, C, D any](a A, b B, c C, d D) Tuple4[A, B, C, D] {
+	return Tuple4[A, B, C, D]{a, b, c, d}
+}
+
+// NewTuple5 returns a Tuple5 of the given values
+func NewTuple5[A, B, C, D, E any](a A, b B, c C, d D, e E) Tuple5[A, B, C, D, E] {
+	return Tuple5[A, B, C, D, E]{a, b, c, d, e}
+}
+
+// NewTuple6 returns a Tuple6 of the given values
+func NewTuple6[A, B, C, D, E, F any](a A, b B, c C, d D, e E, f F) Tuple6[A, B, C, D, E, F] {
+	return Tuple6[A, B, C, D, E, F]{a, b, c, d, e, f}
+}
+
+// First returns the first value of the Pair
+func First[A, B any](p Pair[A, B]) A {
+	return p.First
+}
+
+// Second returns the second value of the Pair
+func Second[A, B any](p Pair[A, B]) B {
+	return p.Second
+}
+
+// Swap swaps the values of the Pair
+func Swap[A, B any](p Pair[A, B]) Pair[B, A] {
+	return Pair[B, A]{p.Second, p.First}
+}
+
+// MapFirst passes the first value of the Pair to function `f` and returns a new
+// Pair with the result of `f` as the first value
+func MapFirst[B, A, C any](f func(A) C) func(Pair[A, B]) Pair[C, B] {
+	return func(p Pair[A, B]) Pair[C, B] {
+		return Pair[C, B]{f(p.First), p.Second}
+	}
+}
+
+// MapSecond passes the second value of the Pair to function `f` and returns a
+// new Pair with the result of `f` as the second value
+func MapSecond[A, B, C any](f func(B) C) func(Pair[A, B]) Pair[A, C] {
+	return func(p Pair[A, B]) Pair[A, C] {
+		return Pair[A, C]{p.First, f(p.Second)}
+	}
+}
+
+// Bimap maps both values of the Pair. Function `fa` is applied to the first
+// value and function `fb` is applied to the second value
+func Bimap[A, B, C, D any](fa func(A) C, fb func(B) D) func(Pair[A, B]) Pair[C, D] {
+	return func(p Pair[A, B]) Pair[C, D] {
+		return Pair[C, D]{fa(p.First), fb(p.Second)}
+	}
+}
+
+// Unpack returns the values of the Pair as a tuple `(A, B)`
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// Unpack returns the values of the Triple as a tuple `(A, B, C)`
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// Unpack returns the values of the Tuple4 as a tuple `(A, B, C, D)`
+func (t Tuple4[A, B, C, D]) Unpack() (A, B, C, D) {
+	return t.First, t.Second, t.Third, t.Fourth
+}
+
+// Unpack returns the values of the Tuple5 as a tuple `(A, B, C, D, E)`
+func (t Tuple5[A, B, C, D, E]) Unpack() (A, B, C, D, E) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth
+}
+
+// Unpack returns the values of the Tuple6 as a tuple `(A, B, C, D, E, F)`
+func (t Tuple6[A, B, C, D, E, F]) Unpack() (A, B, C, D, E, F) {
+	return t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth
+}
+
+// String implements the fmt.Stringer interface. Pair is formatted as `(a, b)`
+func (p Pair[A, B]) String() string {
+	return format(p.First, p.Second)
+}
+
+// String implements the fmt.Stringer interface. Triple is formatted as
+// `(a, b, c)`
+func (t Triple[A, B, C]) String() string {
+	return format(t.First, t.Second, t.Third)
+}
+
+// String implements the fmt.Stringer interface. Tuple4 is formatted as
+// `(a, b, c, d)`
+func (t Tuple4[A, B, C, D]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth)
+}
+
+// String implements the fmt.Stringer interface. Tuple5 is formatted as
+// `(a, b, c, d, e)`
+func (t Tuple5[A, B, C, D, E]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth, t.Fifth)
+}
+
+// String implements the fmt.Stringer interface. Tuple6 is formatted as
+// `(a, b, c, d, e, f)`
+func (t Tuple6[A, B, C, D, E, F]) String() string {
+	return format(t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth)
+}
+
+// MarshalJSON implements the json.Marshaler interface. Pair is encoded as a
+// JSON array `[a, b]`
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{p.First, p.Second})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Pair is decoded
+// from a JSON array `[a, b]`, and a JSON `null` leaves it unchanged
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	return unmarshal(data, &p.First, &p.Second)
+}
+
+// MarshalJSON implements the json.Marshaler interface. Triple is encoded as a
+// JSON array `[a, b, c]`
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Triple is decoded
+// from a JSON array `[a, b, c]`, and a JSON `null` leaves it unchanged
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	return unmarshal(data, &t.First, &t.Second, &t.Third)
+}
+
+// MarshalJSON implements the json.Marshaler interface. Tuple4 is encoded as a
+// JSON array `[a, b, c, d]`
+func (t Tuple4[A, B, C, D]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Tuple4 is decoded
+// from a JSON array `[a, b, c, d]`, and a JSON `null` leaves it unchanged
+func (t *Tuple4[A, B, C, D]) UnmarshalJSON(data []byte) error {
+	return unmarshal(data, &t.First, &t.Second, &t.Third, &t.Fourth)
+}
+
+// MarshalJSON implements the json.Marshaler interface. Tuple5 is encoded as a
+// JSON array `[a, b, c, d, e]`
+func (t Tuple5[A, B, C, D, E]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Tuple5 is decoded
+// from a JSON array `[a, b, c, d, e]`, and a JSON `null` leaves it unchanged
+func (t *Tuple5[A, B, C, D, E]) UnmarshalJSON(data []byte) error {
+	return unmarshal(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth)
+}
+
+// MarshalJSON implements the json.Marshaler interface. Tuple6 is encoded as a
+// JSON array `[a, b, c, d, e, f]`
+func (t Tuple6[A, B, C, D, E, F]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third, t.Fourth, t.Fifth, t.Sixth})
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. Tuple6 is decoded
+// from a JSON array `[a, b, c, d, e, f]`, and a JSON `null` leaves it unchanged
+func (t *Tuple6[A, B, C, D, E, F]) UnmarshalJSON(data []byte) error {
+	return unmarshal(data, &t.First, &t.Second, &t.Third, &t.Fourth, &t.Fifth, &t.Sixth)
+}
+
+// internal
+func format(vals ...any) string {
+	s := "("
+	for i, v := range vals {
+		if i > 0 {
+			s += ", "
+		}
+		s += fmt.Sprint(v)
+	}
+	return s + ")"
+}
+
+func unmarshal(data []byte, dests ...any) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil
+	}
+
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != len(dests) {
+		return fmt.Errorf("tuple: expected a JSON array of %d elements, but got %d", len(dests), len(raw))
+	}
+	for i, dest := range dests {
+		if err := json.Unmarshal(raw[i], dest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
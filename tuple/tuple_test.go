@@ -0,0 +1,142 @@
+package tuple
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+func TestPair(t *testing.T) {
+	tests := []struct {
+		expected string
+		result   any
+	}{
+		{"1", First(NewPair(1, "one"))},
+		{"one", Second(NewPair(1, "one"))},
+		{"(one, 1)", Swap(NewPair(1, "one"))},
+		{"(2, one)", MapFirst[string](func(x int) int { return x * 2 })(NewPair(1, "one"))},
+		{"(1, ONE)", MapSecond[int](strings.ToUpper)(NewPair(1, "one"))},
+		{"(1, ONE)", Bimap(strconv.Itoa, strings.ToUpper)(NewPair(1, "one"))},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := fmt.Sprint(tt.result); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestPipe(t *testing.T) {
+	result := pipe.Pipe3(
+		func(s string) Pair[string, int] { return NewPair(s, len(s)) },
+		MapSecond[string](func(n int) int { return n * 2 }),
+		Swap[string, int],
+	)("hello")
+
+	if a, b := result.Unpack(); a != 10 || b != "hello" {
+		t.Errorf("expected %s, but got %s", "(10, hello)", result)
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		expected string
+		result   fmt.Stringer
+	}{
+		{"(1, a)", NewPair(1, "a")},
+		{"(1, a, true)", NewTriple(1, "a", true)},
+		{"(1, a, true, 2)", NewTuple4(1, "a", true, 2)},
+		{"(1, a, true, 2, b)", NewTuple5(1, "a", true, 2, "b")},
+		{"(1, a, true, 2, b, false)", NewTuple6(1, "a", true, 2, "b", false)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := tt.result.String(); result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestUnpack(t *testing.T) {
+	a, b, c, d, e, f := NewTuple6(1, "a", true, 2, "b", false).Unpack()
+
+	if result := fmt.Sprintf("%v %v %v %v %v %v", a, b, c, d, e, f); result != "1 a true 2 b false" {
+		t.Errorf("expected %s, but got %s", "1 a true 2 b false", result)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     any
+		dest     any
+	}{
+		{`[1,"a"]`, NewPair(1, "a"), &Pair[int, string]{}},
+		{`[1,"a",true]`, NewTriple(1, "a", true), &Triple[int, string, bool]{}},
+		{`[1,"a",true,2]`, NewTuple4(1, "a", true, 2), &Tuple4[int, string, bool, int]{}},
+		{`[1,"a",true,2,"b"]`, NewTuple5(1, "a", true, 2, "b"), &Tuple5[int, string, bool, int, string]{}},
+		{`[1,"a",true,2,"b",false]`, NewTuple6(1, "a", true, 2, "b", false), &Tuple6[int, string, bool, int, string, bool]{}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result, err := json.Marshal(tt.data)
+			if err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+
+			if string(result) != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+
+			if err := json.Unmarshal(result, tt.dest); err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+
+			if dest := fmt.Sprint(tt.dest); dest != fmt.Sprint(tt.data) {
+				t.Errorf("expected %s, but got %s", fmt.Sprint(tt.data), dest)
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	tests := []string{`[1]`, `[1,"a",2]`, `{"First":1}`, `["a","a"]`}
+
+	for _, data := range tests {
+		t.Run("", func(t *testing.T) {
+			var p Pair[int, string]
+			if err := json.Unmarshal([]byte(data), &p); err == nil {
+				t.Errorf("expected an error, but got nil")
+			}
+		})
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	var dto struct {
+		Pair   Pair[int, string]
+		Triple *Triple[int, string, bool]
+	}
+	dto.Pair = NewPair(1, "a")
+
+	if err := json.Unmarshal([]byte(`{"Pair":null,"Triple":null}`), &dto); err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	if expected := NewPair(1, "a"); dto.Pair != expected {
+		t.Errorf("expected %v, but got %v", expected, dto.Pair)
+	}
+
+	if dto.Triple != nil {
+		t.Errorf("expected %v, but got %v", nil, dto.Triple)
+	}
+}
@@ -0,0 +1,220 @@
+// Validation applicative represents either a valid value `Valid` or the
+// accumulated failures `Invalid` of a computation.
+//
+// Validation is similar to Either and Result monads, the main difference is
+// that it does not short-circuit on the first failure. Instead, when
+// independent validations are combined, all failures are accumulated and
+// reported at once. This makes Validation particularly useful for form and
+// configuration validation, where every failure should be reported to the
+// user and not only the first one.
+//
+// Failures are accumulated in the order they occur. The accumulated failures
+// can be combined into a single failure with errors.Join by converting the
+// Validation into Result monad with `ToResult`, or with a user-supplied
+// Semigroup by using `Concat`.
+package validation
+
+import (
+	"errors"
+
+	"github.com/erikjuhani/go-fp/result"
+)
+
+// Validation data type representation. Contains either value `a` or the
+// accumulated failures `e`
+type Validation[E, A any] struct {
+	errs []E
+	val  A
+}
+
+// Semigroup is an associative operation that combines two values of the same
+// type into one, such as errors.Join for errors or concatenation for strings
+type Semigroup[E any] func(E, E) E
+
+// Valid is the return operation for Validation that returns the
+// representation of a valid value
+func Valid[E, A any](val A) Validation[E, A] {
+	return Validation[E, A]{val: val}
+}
+
+// Invalid is the return operation for Validation that returns the
+// representation of a failed validation with at least one failure
+func Invalid[A, E any](err E, errs ...E) Validation[E, A] {
+	return Validation[E, A]{errs: append([]E{err}, errs...)}
+}
+
+// IsValid is a helper function for Validation and returns true if the
+// Validation contains a valid value
+func IsValid[E, A any](m Validation[E, A]) bool {
+	return len(m.errs) == 0
+}
+
+// IsInvalid is a helper function for Validation and returns true if the
+// Validation contains failures
+func IsInvalid[E, A any](m Validation[E, A]) bool {
+	return len(m.errs) > 0
+}
+
+// Errors returns the accumulated failures of the Validation. Returns an empty
+// slice if the Validation is valid
+func Errors[E, A any](m Validation[E, A]) []E {
+	return append([]E(nil), m.errs...)
+}
+
+// Map function takes the contents of the Validation and passes it to function
+// `f` as a parameter. The function `f` returns a new Validation as the result
+func Map[E, A, B any](f func(A) B) func(Validation[E, A]) Validation[E, B] {
+	return func(m Validation[E, A]) Validation[E, B] {
+		if IsInvalid(m) {
+			return Validation[E, B]{errs: m.errs}
+		}
+		return Valid[E](f(m.val))
+	}
+}
+
+// MapErr function passes each accumulated failure of the Validation to
+// function `f` as a parameter. The function `f` returns a new failure
+func MapErr[A, E, F any](f func(E) F) func(Validation[E, A]) Validation[F, A] {
+	return func(m Validation[E, A]) Validation[F, A] {
+		if IsValid(m) {
+			return Valid[F](m.val)
+		}
+		errs := make([]F, len(m.errs))
+		for i, err := range m.errs {
+			errs[i] = f(err)
+		}
+		return Validation[F, A]{errs: errs}
+	}
+}
+
+// Map2 applies function `f` to the values of two Validations if both are
+// valid. Otherwise the failures of both Validations are accumulated
+func Map2[E, A, B, C any](f func(A, B) C) func(Validation[E, A], Validation[E, B]) Validation[E, C] {
+	return func(a Validation[E, A], b Validation[E, B]) Validation[E, C] {
+		if errs := concat(a.errs, b.errs); len(errs) > 0 {
+			return Validation[E, C]{errs: errs}
+		}
+		return Valid[E](f(a.val, b.val))
+	}
+}
+
+// Map3 applies function `f` to the values of three Validations if all are
+// valid. Otherwise the failures of all Validations are accumulated
+func Map3[E, A, B, C, D any](f func(A, B, C) D) func(Validation[E, A], Validation[E, B], Validation[E, C]) Validation[E, D] {
+	return func(a Validation[E, A], b Validation[E, B], c Validation[E, C]) Validation[E, D] {
+		if errs := concat(a.errs, b.errs, c.errs); len(errs) > 0 {
+			return Validation[E, D]{errs: errs}
+		}
+		return Valid[E](f(a.val, b.val, c.val))
+	}
+}
+
+// Map4 applies function `f` to the values of four Validations if all are
+// valid. Otherwise the failures of all Validations are accumulated
+func Map4[E, A, B, C, D, F any](f func(A, B, C, D) F) func(Validation[E, A], Validation[E, B], Validation[E, C], Validation[E, D]) Validation[E, F] {
+	return func(a Validation[E, A], b Validation[E, B], c Validation[E, C], d Validation[E, D]) Validation[E, F] {
+		if errs := concat(a.errs, b.errs, c.errs, d.errs); len(errs) > 0 {
+			return Validation[E, F]{errs: errs}
+		}
+		return Valid[E](f(a.val, b.val, c.val, d.val))
+	}
+}
+
+// Map5 applies function `f` to the values of five Validations if all are
+// valid. Otherwise the failures of all Validations are accumulated
+func Map5[E, A, B, C, D, F, G any](f func(A, B, C, D, F) G) func(Validation[E, A], Validation[E, B], Validation[E, C], Validation[E, D], Validation[E, F]) Validation[E, G] {
+	return func(a Validation[E, A], b Validation[E, B], c Validation[E, C], d Validation[E, D], e Validation[E, F]) Validation[E, G] {
+		if errs := concat(a.errs, b.errs, c.errs, d.errs, e.errs); len(errs) > 0 {
+			return Validation[E, G]{errs: errs}
+		}
+		return Valid[E](f(a.val, b.val, c.val, d.val, e.val))
+	}
+}
+
+// Sequence turns a slice of Validations into a Validation of a slice. The
+// result is valid only if all Validations are valid, otherwise the failures of
+// all Validations are accumulated
+func Sequence[E, A any](ms []Validation[E, A]) Validation[E, []A] {
+	var (
+		errs []E
+		vals = make([]A, 0, len(ms))
+	)
+	for _, m := range ms {
+		errs = append(errs, m.errs...)
+		vals = append(vals, m.val)
+	}
+	if len(errs) > 0 {
+		return Validation[E, []A]{errs: errs}
+	}
+	return Valid[E](vals)
+}
+
+// Traverse applies function `f` to each element of the slice and accumulates
+// the results into a Validation of a slice, like Sequence
+func Traverse[E, A, B any](f func(A) Validation[E, B]) func([]A) Validation[E, []B] {
+	return func(as []A) Validation[E, []B] {
+		ms := make([]Validation[E, B], len(as))
+		for i, a := range as {
+			ms[i] = f(a)
+		}
+		return Sequence(ms)
+	}
+}
+
+// Concat combines the accumulated failures of the Validation into a single
+// failure with the given Semigroup `s`
+func Concat[A, E any](s Semigroup[E]) func(Validation[E, A]) Validation[E, A] {
+	return func(m Validation[E, A]) Validation[E, A] {
+		if IsValid(m) {
+			return m
+		}
+		err := m.errs[0]
+		for _, e := range m.errs[1:] {
+			err = s(err, e)
+		}
+		return Invalid[A](err)
+	}
+}
+
+// Match matches Validation depending of it's current state and returns the
+// value determined by the return type of b
+func Match[E, A, B any](Invalid func(errs []E) B, Valid func(val A) B) func(Validation[E, A]) B {
+	return func(m Validation[E, A]) B {
+		if IsInvalid(m) {
+			return Invalid(Errors(m))
+		}
+		return Valid(m.val)
+	}
+}
+
+// FromResult converts Result monad into Validation. Err is converted into a
+// failed validation and Ok into a valid value
+func FromResult[A any](m result.Result[A]) Validation[error, A] {
+	return result.Match(
+		func(err error) Validation[error, A] { return Invalid[A](err) },
+		Valid[error, A],
+	)(m)
+}
+
+// ToResult converts Validation into Result monad. The accumulated failures are
+// combined into a single error with errors.Join. If all of the failures are
+// `nil`, the error is result.ErrNil
+func ToResult[A any](m Validation[error, A]) result.Result[A] {
+	if IsInvalid(m) {
+		err := errors.Join(m.errs...)
+		if err == nil {
+			err = result.ErrNil
+		}
+		return result.Err[A](err)
+	}
+	return result.Ok(m.val)
+}
+
+// internal
+func concat[E any](errss ...[]E) []E {
+	var errs []E
+	for _, e := range errss {
+		errs = append(errs, e...)
+	}
+	return errs
+}
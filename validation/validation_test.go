@@ -0,0 +1,220 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+	"github.com/erikjuhani/go-fp/result"
+)
+
+type user struct {
+	name  string
+	email string
+	age   int
+}
+
+func validateName(name string) Validation[error, string] {
+	if name == "" {
+		return Invalid[string](errors.New("name is required"))
+	}
+	return Valid[error](name)
+}
+
+func validateEmail(email string) Validation[error, string] {
+	if !strings.Contains(email, "@") {
+		return Invalid[string](errors.New("email is invalid"))
+	}
+	return Valid[error](email)
+}
+
+func validateAge(age int) Validation[error, int] {
+	if age < 0 {
+		return Invalid[int](errors.New("age must be positive"))
+	}
+	return Valid[error](age)
+}
+
+func newUser(name, email string, age int) user {
+	return user{name, email, age}
+}
+
+func show[A any](m Validation[error, A]) string {
+	return Match(
+		func(errs []error) string { return errors.Join(errs...).Error() },
+		func(val A) string { return fmt.Sprint(val) },
+	)(m)
+}
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     string
+	}{
+		{"name is required", ""},
+		{"JOHN", "john"},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				validateName,
+				Map[error](strings.ToUpper),
+			)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestMap3(t *testing.T) {
+	tests := []struct {
+		expected string
+		name     string
+		email    string
+		age      int
+	}{
+		{"name is required\nemail is invalid\nage must be positive", "", "", -1},
+		{"name is required\nage must be positive", "", "john@example.com", -1},
+		{"email is invalid", "john", "john", 42},
+		{"{john john@example.com 42}", "john", "john@example.com", 42},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Map3[error](newUser)(
+				validateName(tt.name),
+				validateEmail(tt.email),
+				validateAge(tt.age),
+			)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestMapN(t *testing.T) {
+	var (
+		valid   = Valid[string](1)
+		invalid = Invalid[int]("invalid")
+		sum2    = func(a, b int) int { return a + b }
+		sum4    = func(a, b, c, d int) int { return a + b + c + d }
+		sum5    = func(a, b, c, d, e int) int { return a + b + c + d + e }
+	)
+
+	tests := []struct {
+		expected int
+		result   Validation[string, int]
+	}{
+		{0, Map2[string](sum2)(valid, valid)},
+		{1, Map2[string](sum2)(invalid, valid)},
+		{2, Map2[string](sum2)(invalid, invalid)},
+		{0, Map4[string](sum4)(valid, valid, valid, valid)},
+		{3, Map4[string](sum4)(invalid, valid, invalid, invalid)},
+		{0, Map5[string](sum5)(valid, valid, valid, valid, valid)},
+		{5, Map5[string](sum5)(invalid, invalid, invalid, invalid, invalid)},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			if result := len(Errors(tt.result)); result != tt.expected {
+				t.Errorf("expected %d, but got %d", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     []int
+	}{
+		{"[]", []int{}},
+		{"[1 2 3]", []int{1, 2, 3}},
+		{"age must be positive\nage must be positive", []int{-1, 2, -3}},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := Traverse(validateAge)(tt.data)
+
+			if res := show(result); res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+		})
+	}
+}
+
+func TestConcat(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Validation[string, int]
+	}{
+		{"1", Valid[string](1)},
+		{"a", Invalid[int]("a")},
+		{"a, b, c", Invalid[int]("a", "b", "c")},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result := pipe.Pipe2(
+				Concat[int](func(a, b string) string { return a + ", " + b }),
+				Match(
+					func(errs []string) string { return strings.Join(errs, "|") },
+					func(val int) string { return fmt.Sprint(val) },
+				),
+			)(tt.data)
+
+			if result != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestMapErr(t *testing.T) {
+	result := MapErr[int](strings.ToUpper)(Invalid[int]("a", "b"))
+
+	if res := strings.Join(Errors(result), ""); res != "AB" {
+		t.Errorf("expected %s, but got %s", "AB", res)
+	}
+}
+
+func TestResultConversion(t *testing.T) {
+	tests := []struct {
+		expected string
+		data     Validation[error, int]
+	}{
+		{"1", Valid[error](1)},
+		{"age must be positive", validateAge(-1)},
+		{"result: nil error", Invalid[int, error](nil)},
+		{"name is required\nemail is invalid", Map2[error](func(string, string) int { return 0 })(validateName(""), validateEmail(""))},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			res := pipe.Pipe3(
+				ToResult[int],
+				result.Map(func(val int) int { return val }),
+				result.Match(
+					func(err error) string { return err.Error() },
+					func(val int) string { return fmt.Sprint(val) },
+				),
+			)(tt.data)
+
+			if res != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, res)
+			}
+
+			if back := show(FromResult(ToResult(tt.data))); back != tt.expected {
+				t.Errorf("expected %s, but got %s", tt.expected, back)
+			}
+		})
+	}
+}
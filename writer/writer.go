@@ -0,0 +1,141 @@
+// Writer monad represents computations that produce a value along with an
+// accumulated output `(Result, Output)`, such as a log, an audit trail or
+// metric counters.
+//
+// Writer monad provides a way to accumulate output in a purely functional
+// manner. Unlike State monad, a computation step can only append to the
+// output, it can never read or overwrite the output produced by the previous
+// steps.
+//
+// The output is accumulated with a Monoid, which defines an empty output and
+// an associative operation to combine two outputs.
+package writer
+
+import "github.com/erikjuhani/go-fp/result"
+
+// Writer represents the writer monad type, which is a computation that
+// produces a value `a` and an output `w` as a tuple `(Result, Output)`
+type Writer[W, A any] func() (A, W)
+
+// Monoid defines how the output of Writer monad is accumulated. Empty is the
+// identity value of the output and Concat is an associative operation that
+// combines two outputs into one
+type Monoid[W any] struct {
+	Empty  W
+	Concat func(W, W) W
+}
+
+// Number is a constraint for numeric types that can be summed
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Void represents an unit or void that is present in an operation that does
+// not produce a result
+type Void = result.Void
+
+// Slice returns a Monoid that accumulates the output by appending to a slice
+func Slice[T any]() Monoid[[]T] {
+	return Monoid[[]T]{
+		Concat: func(a, b []T) []T {
+			return append(append(make([]T, 0, len(a)+len(b)), a...), b...)
+		},
+	}
+}
+
+// Sum returns a Monoid that accumulates the output by summing numbers
+func Sum[N Number]() Monoid[N] {
+	return Monoid[N]{Concat: func(a, b N) N { return a + b }}
+}
+
+// String returns a Monoid that accumulates the output by concatenating strings
+func String() Monoid[string] {
+	return Monoid[string]{Concat: func(a, b string) string { return a + b }}
+}
+
+// Of is the return operation for Writer monad that returns the given value `a`
+// with an empty output
+func Of[W, A any](m Monoid[W], val A) Writer[W, A] {
+	return func() (A, W) { return val, m.Empty }
+}
+
+// From is the return operation for Writer monad that returns the given value
+// `a` along with the output `w`
+func From[W, A any](val A, w W) Writer[W, A] {
+	return func() (A, W) { return val, w }
+}
+
+// Tell produces the given output `w`. Tell does not yield any meaningful
+// result, it only appends to the output
+func Tell[W any](w W) Writer[W, Void] {
+	return func() (Void, W) { return Void{}, w }
+}
+
+// Listen gives access to the output produced by the Writer monad. The function
+// `f` is called with the result and the output and returns a new result
+func Listen[W, A, B any](f func(A, W) B) func(Writer[W, A]) Writer[W, B] {
+	return func(m Writer[W, A]) Writer[W, B] {
+		return func() (B, W) {
+			a, w := m()
+			return f(a, w), w
+		}
+	}
+}
+
+// Censor transforms the output produced by the Writer monad with the given
+// function `f`, without affecting the result
+func Censor[A, W any](f func(W) W) func(Writer[W, A]) Writer[W, A] {
+	return func(m Writer[W, A]) Writer[W, A] {
+		return func() (A, W) {
+			a, w := m()
+			return a, f(w)
+		}
+	}
+}
+
+// Run runs the Writer monad and returns both the result and the output as a
+// tuple `(Result, Output)`
+func Run[W, A any](m Writer[W, A]) (A, W) {
+	return m()
+}
+
+// Exec discards the computed result and returns only the output. Exec is
+// useful when only interested in the accumulated output.
+func Exec[W, A any](m Writer[W, A]) W {
+	_, w := m()
+	return w
+}
+
+// Eval discards the output and returns only the computed result. Eval is
+// useful when only interested in the computation result.
+func Eval[W, A any](m Writer[W, A]) A {
+	a, _ := m()
+	return a
+}
+
+// Map function takes the contents of the Writer monad and passes it to
+// function `f` as a parameter. The function `f` returns a new Writer monad as
+// the result
+func Map[W, A, B any](f func(A) B) func(Writer[W, A]) Writer[W, B] {
+	return func(m Writer[W, A]) Writer[W, B] {
+		return func() (B, W) {
+			a, w := m()
+			return f(a), w
+		}
+	}
+}
+
+// Fmap or also known as `bind` function lets non-monadic function `f` to
+// operate on the contents of monad m a, and lifts the value to a new domain
+// (Writer a -> Writer b). The outputs are combined with the given Monoid `mo`
+func Fmap[A, B, W any](mo Monoid[W], f func(A) Writer[W, B]) func(Writer[W, A]) Writer[W, B] {
+	return func(m Writer[W, A]) Writer[W, B] {
+		return func() (B, W) {
+			a, w1 := m()
+			b, w2 := f(a)()
+			return b, mo.Concat(w1, w2)
+		}
+	}
+}
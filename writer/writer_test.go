@@ -0,0 +1,114 @@
+package writer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/erikjuhani/go-fp/pipe"
+)
+
+var logs = Slice[string]()
+
+func double(x int) Writer[[]string, int] {
+	return From(x*2, []string{fmt.Sprintf("doubled %d", x)})
+}
+
+func increment(x int) Writer[[]string, int] {
+	return From(x+1, []string{fmt.Sprintf("incremented %d", x)})
+}
+
+func TestFmap(t *testing.T) {
+	tests := []struct {
+		expected    int
+		expectedLog string
+		data        int
+	}{
+		{2, "doubled 0, incremented 0, doubled 1", 0},
+		{6, "doubled 1, incremented 2, doubled 3", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run("", func(t *testing.T) {
+			result, log := Run(pipe.Pipe3(
+				double,
+				Fmap(logs, increment),
+				Fmap(logs, double),
+			)(tt.data))
+
+			if result != tt.expected {
+				t.Errorf("expected %d, but got %d", tt.expected, result)
+			}
+
+			if l := strings.Join(log, ", "); l != tt.expectedLog {
+				t.Errorf("expected %s, but got %s", tt.expectedLog, l)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	result := pipe.Pipe2(
+		Map[int](func(x int) string { return fmt.Sprint(x) }),
+		Eval[int, string],
+	)(Of(Sum[int](), 1))
+
+	if result != "1" {
+		t.Errorf("expected %s, but got %s", "1", result)
+	}
+}
+
+func TestTell(t *testing.T) {
+	counter := Sum[int]()
+
+	count := func(x int) Writer[int, int] {
+		return Map[int](func(Void) int { return x })(Tell(1))
+	}
+
+	result := pipe.Pipe4(
+		count,
+		Fmap(counter, count),
+		Fmap(counter, count),
+		Exec[int, int],
+	)(42)
+
+	if result != 3 {
+		t.Errorf("expected %d, but got %d", 3, result)
+	}
+}
+
+func TestListen(t *testing.T) {
+	result := pipe.Pipe3(
+		double,
+		Listen(func(x int, log []string) string { return fmt.Sprintf("%d after %d steps", x, len(log)) }),
+		Eval[[]string, string],
+	)(2)
+
+	if expected := "4 after 1 steps"; result != expected {
+		t.Errorf("expected %s, but got %s", expected, result)
+	}
+}
+
+func TestCensor(t *testing.T) {
+	result := pipe.Pipe4(
+		double,
+		Fmap(logs, increment),
+		Censor[int](func(log []string) []string { return log[len(log)-1:] }),
+		Exec[[]string, int],
+	)(2)
+
+	if l := strings.Join(result, ", "); l != "incremented 4" {
+		t.Errorf("expected %s, but got %s", "incremented 4", l)
+	}
+}
+
+func TestString(t *testing.T) {
+	result := pipe.Pipe2(
+		Fmap(String(), func(x int) Writer[string, int] { return From(x, "b") }),
+		Exec[string, int],
+	)(From(1, "a"))
+
+	if result != "ab" {
+		t.Errorf("expected %s, but got %s", "ab", result)
+	}
+}